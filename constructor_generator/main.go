@@ -63,7 +63,7 @@ func main() {
 
 	criterias := make([]constructorCriteria, 0)
 	allImports := parseImports(sourceFile)
-	usedImports := set.New[importCriteria]()
+	usedImports := set.NewOrdered[importCriteria]()
 
 	ast.Inspect(sourceFile, func(node ast.Node) bool {
 		switch node := node.(type) {
@@ -123,7 +123,7 @@ func main() {
 				fields := parseStructFields(structType)
 				imports := filterUsedImportsForFields(fields, allImports)
 
-				usedImports = sets.Union(usedImports, set.FromSlice(imports))
+				usedImports = sets.UnionOrdered(usedImports, set.OrderedFromSlice(imports))
 
 				var constructorName string
 				if isPublicInstance {
@@ -161,7 +161,8 @@ func main() {
 	}
 }
 
-// filterUsedImportsForFields returns only imports that are used in the specific struct fields
+// filterUsedImportsForFields returns only imports that are used in the specific struct fields,
+// keeping the order they are declared in the source file
 func filterUsedImportsForFields(fields []fieldCriteria, allImports []importCriteria) []importCriteria {
 	usedImports := make(map[string]importCriteria)
 
@@ -179,10 +180,12 @@ func filterUsedImportsForFields(fields []fieldCriteria, allImports []importCrite
 		}
 	}
 
-	// Convert map back to slice
+	// Convert map back to slice in declaration order
 	var result []importCriteria
-	for _, imp := range usedImports {
-		result = append(result, imp)
+	for _, imp := range allImports {
+		if _, ok := usedImports[imp.Name]; ok {
+			result = append(result, imp)
+		}
 	}
 
 	return result
@@ -0,0 +1,89 @@
+package textcodec
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// Marshal encodes pointed value as text, using its encoding.TextMarshaler implementation if there is one
+func Marshal(value any) ([]byte, error) {
+	if marshaler, ok := value.(encoding.TextMarshaler); ok {
+		return marshaler.MarshalText()
+	}
+
+	v := reflect.ValueOf(value).Elem()
+	if v.Type() == durationType {
+		return []byte(time.Duration(v.Int()).String()), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), nil
+	default:
+		return nil, fmt.Errorf("unsupported text type: %s", v.Type())
+	}
+}
+
+// Unmarshal decodes text into pointed value, using its encoding.TextUnmarshaler implementation if there is one
+func Unmarshal(text []byte, value any) error {
+	if unmarshaler, ok := value.(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText(text)
+	}
+
+	v := reflect.ValueOf(value).Elem()
+	if v.Type() == durationType {
+		duration, err := time.ParseDuration(string(text))
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(int64(duration))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(string(text))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(string(text), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(string(text), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(string(text))
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(string(text), v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported text type: %s", v.Type())
+	}
+
+	return nil
+}
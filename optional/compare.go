@@ -0,0 +1,57 @@
+package optional
+
+import "cmp"
+
+// NoneOrder defines whether None values go before or after Some ones
+type NoneOrder int
+
+const (
+	NoneFirst NoneOrder = iota
+	NoneLast
+)
+
+// Equal reports whether both optionals are None or both hold equal values.
+// Since None always holds zero V, for comparable V it's the same as a == b,
+// so such optionals are also usable as map and set.T keys
+func Equal[V comparable](a, b T[V]) bool {
+	if a.isNotNull != b.isNotNull {
+		return false
+	}
+
+	return !a.isNotNull || a.value == b.value
+}
+
+// Compare compares optionals with None sorted first
+func Compare[V cmp.Ordered](a, b T[V]) int {
+	return compareFunc(a, b, NoneFirst, cmp.Compare[V])
+}
+
+// Comparator returns function usable with slices.SortFunc and similar
+func Comparator[V cmp.Ordered](order NoneOrder) func(a, b T[V]) int {
+	return ComparatorFunc(order, cmp.Compare[V])
+}
+
+func ComparatorFunc[V any](order NoneOrder, compare func(a, b V) int) func(a, b T[V]) int {
+	return func(a, b T[V]) int {
+		return compareFunc(a, b, order, compare)
+	}
+}
+
+func compareFunc[V any](a, b T[V], order NoneOrder, compare func(a, b V) int) int {
+	switch {
+	case !a.isNotNull && !b.isNotNull:
+		return 0
+	case !a.isNotNull:
+		if order == NoneLast {
+			return 1
+		}
+		return -1
+	case !b.isNotNull:
+		if order == NoneLast {
+			return -1
+		}
+		return 1
+	default:
+		return compare(a.value, b.value)
+	}
+}
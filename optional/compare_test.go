@@ -0,0 +1,86 @@
+package optional_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+	"github.com/leshless/golibrary/set"
+)
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		name   string
+		a      optional.T[int]
+		b      optional.T[int]
+		result bool
+	}{
+		{"BothNone", optional.None[int](), optional.T[int]{}, true},
+		{"NoneAndZero", optional.None[int](), optional.Some(0), false},
+		{"SameValue", optional.Some(1), optional.Some(1), true},
+		{"DifferentValue", optional.Some(1), optional.Some(2), false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := optional.Equal(testCase.a, testCase.b); result != testCase.result {
+				t.Logf("expected: %t, got: %t", testCase.result, result)
+				t.Fail()
+			}
+
+			if result := testCase.a == testCase.b; result != testCase.result {
+				t.Logf("operator mismatch, expected: %t, got: %t", testCase.result, result)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestComparator(t *testing.T) {
+	input := []optional.T[int]{optional.Some(2), optional.None[int](), optional.Some(-1), {}, optional.Some(0)}
+
+	testCases := []struct {
+		name   string
+		order  optional.NoneOrder
+		result []optional.T[int]
+	}{
+		{
+			name:   "NoneFirst",
+			order:  optional.NoneFirst,
+			result: []optional.T[int]{{}, {}, optional.Some(-1), optional.Some(0), optional.Some(2)},
+		},
+		{
+			name:   "NoneLast",
+			order:  optional.NoneLast,
+			result: []optional.T[int]{optional.Some(-1), optional.Some(0), optional.Some(2), {}, {}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := slices.Clone(input)
+			slices.SortFunc(result, optional.Comparator[int](testCase.order))
+
+			if !slices.Equal(result, testCase.result) {
+				t.Logf("expected: %v, got: %v", testCase.result, result)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestSetKey(t *testing.T) {
+	s := set.FromSlice([]optional.T[string]{
+		optional.Some("a"),
+		optional.Some("a"),
+		optional.None[string](),
+		{},
+		optional.FromPointer[string](nil),
+		optional.Some(""),
+	})
+
+	if len(s) != 3 || !s.Contains(optional.T[string]{}) || !s.Contains(optional.Some("")) {
+		t.Logf("unexpected set: %v", s.Slice())
+		t.Fail()
+	}
+}
@@ -0,0 +1,141 @@
+package optional
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/leshless/golibrary/internal/textcodec"
+)
+
+var _ encoding.TextMarshaler = T[struct{}]{}
+var _ encoding.TextUnmarshaler = (*T[struct{}])(nil)
+var _ xml.Marshaler = T[struct{}]{}
+var _ xml.Unmarshaler = (*T[struct{}])(nil)
+var _ xml.MarshalerAttr = T[struct{}]{}
+var _ xml.UnmarshalerAttr = (*T[struct{}])(nil)
+var _ encoding.BinaryMarshaler = T[struct{}]{}
+var _ encoding.BinaryUnmarshaler = (*T[struct{}])(nil)
+
+const (
+	binaryNullFlag    byte = 0
+	binaryNotNullFlag byte = 1
+)
+
+// MarshalText encodes None as empty text, so for string optionals Some("") and None are indistinguishable
+func (t T[V]) MarshalText() ([]byte, error) {
+	if !t.isNotNull {
+		return []byte{}, nil
+	}
+
+	return textcodec.Marshal(&t.value)
+}
+
+// UnmarshalText decodes empty text as None
+func (t *T[V]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = T[V]{}
+		return nil
+	}
+
+	var value V
+	if err := textcodec.Unmarshal(text, &value); err != nil {
+		return err
+	}
+
+	*t = Some(value)
+	return nil
+}
+
+// MarshalXML omits None elements entirely
+func (t T[V]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !t.isNotNull {
+		return nil
+	}
+
+	return e.EncodeElement(t.value, start)
+}
+
+func (t *T[V]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value V
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+
+	*t = Some(value)
+	return nil
+}
+
+// MarshalXMLAttr omits None attributes entirely
+func (t T[V]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !t.isNotNull {
+		return xml.Attr{}, nil
+	}
+
+	if marshaler, ok := any(&t.value).(xml.MarshalerAttr); ok {
+		return marshaler.MarshalXMLAttr(name)
+	}
+
+	text, err := textcodec.Marshal(&t.value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+func (t *T[V]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var value V
+	if unmarshaler, ok := any(&value).(xml.UnmarshalerAttr); ok {
+		if err := unmarshaler.UnmarshalXMLAttr(attr); err != nil {
+			return err
+		}
+	} else if err := textcodec.Unmarshal([]byte(attr.Value), &value); err != nil {
+		return err
+	}
+
+	*t = Some(value)
+	return nil
+}
+
+// MarshalBinary encodes null flag followed by gob encoded value, which also makes T usable with gob directly
+func (t T[V]) MarshalBinary() ([]byte, error) {
+	if !t.isNotNull {
+		return []byte{binaryNullFlag}, nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(binaryNotNullFlag)
+
+	if err := gob.NewEncoder(&buf).Encode(t.value); err != nil {
+		return nil, fmt.Errorf("encoding value: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (t *T[V]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("missing null flag")
+	}
+
+	switch data[0] {
+	case binaryNullFlag:
+		*t = T[V]{}
+		return nil
+	case binaryNotNullFlag:
+	default:
+		return fmt.Errorf("malformed null flag: %d", data[0])
+	}
+
+	var value V
+	if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&value); err != nil {
+		return fmt.Errorf("decoding value: %w", err)
+	}
+
+	*t = Some(value)
+	return nil
+}
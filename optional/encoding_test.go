@@ -0,0 +1,167 @@
+package optional_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/leshless/golibrary/optional"
+)
+
+func TestTextRoundTrip(t *testing.T) {
+	t.Run("Duration", func(t *testing.T) {
+		var result optional.T[time.Duration]
+		if err := result.UnmarshalText([]byte("1m30s")); err != nil {
+			t.Logf("unexpected error: %s", err)
+			t.FailNow()
+		}
+
+		text, err := result.MarshalText()
+		if err != nil {
+			t.Logf("unexpected error: %s", err)
+			t.FailNow()
+		}
+
+		if string(text) != "1m30s" {
+			t.Logf("expected: %s, got: %s", "1m30s", text)
+			t.Fail()
+		}
+	})
+
+	t.Run("Bool", func(t *testing.T) {
+		var result optional.T[bool]
+		if err := result.UnmarshalText([]byte("true")); err != nil {
+			t.Logf("unexpected error: %s", err)
+			t.FailNow()
+		}
+
+		if result != optional.Some(true) {
+			t.Logf("expected: true, got: %s", result.String())
+			t.Fail()
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		result := optional.Some(42)
+		if err := result.UnmarshalText([]byte{}); err != nil {
+			t.Logf("unexpected error: %s", err)
+			t.FailNow()
+		}
+
+		if !result.IsNull() {
+			t.Logf("expected: None, got: %s", result.String())
+			t.Fail()
+		}
+	})
+
+	t.Run("TextUnmarshaler", func(t *testing.T) {
+		var result optional.T[time.Time]
+		if err := result.UnmarshalText([]byte("2025-01-02T03:04:05Z")); err != nil {
+			t.Logf("unexpected error: %s", err)
+			t.FailNow()
+		}
+
+		value, _ := result.Get()
+		if value.Year() != 2025 {
+			t.Logf("unexpected value: %s", result.String())
+			t.Fail()
+		}
+	})
+}
+
+type xmlItem struct {
+	XMLName xml.Name           `xml:"item"`
+	ID      optional.T[int]    `xml:"id,attr"`
+	Name    optional.T[string] `xml:"name"`
+}
+
+func TestXML(t *testing.T) {
+	testCases := []struct {
+		name   string
+		item   xmlItem
+		result string
+	}{
+		{
+			name:   "None",
+			item:   xmlItem{},
+			result: `<item></item>`,
+		},
+		{
+			name: "Some",
+			item: xmlItem{
+				ID:   optional.Some(1),
+				Name: optional.Some("artem"),
+			},
+			result: `<item id="1"><name>artem</name></item>`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			output, err := xml.Marshal(testCase.item)
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if string(output) != testCase.result {
+				t.Logf("expected: %s, got: %s", testCase.result, output)
+				t.Fail()
+			}
+
+			var item xmlItem
+			if err := xml.Unmarshal(output, &item); err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if item.ID != testCase.item.ID || item.Name != testCase.item.Name {
+				t.Logf("expected: %+v, got: %+v", testCase.item, item)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestGob(t *testing.T) {
+	testCases := []struct {
+		name     string
+		optional optional.T[string]
+	}{
+		{
+			name:     "None",
+			optional: optional.None[string](),
+		},
+		{
+			name:     "SomeEmpty",
+			optional: optional.Some(""),
+		},
+		{
+			name:     "Some",
+			optional: optional.Some("value"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(testCase.optional); err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			var result optional.T[string]
+			if err := gob.NewDecoder(&buf).Decode(&result); err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if result != testCase.optional {
+				t.Logf("expected: %s, got: %s", testCase.optional.String(), result.String())
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Field distinguishes three states of a value: absent, explicitly null and set.
+// It's intended for PATCH-like payloads and should be tagged with `json:",omitzero"`,
+// so that absent fields are also omitted on marshalling
+type Field[V any] struct {
+	optional  T[V]
+	isPresent bool
+}
+
+var _ fmt.Stringer = (*Field[struct{}])(nil)
+var _ json.Unmarshaler = (*Field[struct{}])(nil)
+var _ json.Marshaler = Field[struct{}]{}
+
+func SomeField[V any](value V) Field[V] {
+	return Field[V]{
+		optional:  Some(value),
+		isPresent: true,
+	}
+}
+
+func NullField[V any]() Field[V] {
+	return Field[V]{
+		isPresent: true,
+	}
+}
+
+func AbsentField[V any]() Field[V] {
+	return Field[V]{}
+}
+
+// FieldFromOptional returns present field holding the same state as optional
+func FieldFromOptional[V any](optional T[V]) Field[V] {
+	return Field[V]{
+		optional:  optional,
+		isPresent: true,
+	}
+}
+
+func (f *Field[V]) IsPresent() bool {
+	return f.isPresent
+}
+
+func (f *Field[V]) IsNull() bool {
+	return f.isPresent && !f.optional.isNotNull
+}
+
+// IsZero reports whether field is absent, which makes `omitzero` tag work as expected.
+// Value receiver is required for encoding/json to find it on non-addressable fields
+func (f Field[V]) IsZero() bool {
+	return !f.isPresent
+}
+
+func (f *Field[V]) Get() (V, bool) {
+	return f.optional.value, f.optional.isNotNull
+}
+
+// Optional drops the distinction between absent and null fields
+func (f *Field[V]) Optional() T[V] {
+	return f.optional
+}
+
+// ApplyTo overwrites target with field value if it was present, null field resets target to zero value.
+// Returns whether target was overwritten
+func (f *Field[V]) ApplyTo(target *V) bool {
+	if !f.isPresent {
+		return false
+	}
+
+	*target = f.optional.value
+	return true
+}
+
+func (f *Field[V]) String() string {
+	if !f.isPresent {
+		return absentPlaceholder
+	}
+
+	return f.optional.String()
+}
+
+func (f *Field[V]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*f = NullField[V]()
+		return nil
+	}
+
+	var value V
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*f = SomeField(value)
+	return nil
+}
+
+func (f Field[V]) MarshalJSON() ([]byte, error) {
+	return f.optional.MarshalJSON()
+}
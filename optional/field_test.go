@@ -0,0 +1,77 @@
+package optional_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+)
+
+type patchRequest struct {
+	Name optional.Field[string] `json:"name,omitzero"`
+}
+
+func TestFieldUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		isPresent bool
+		isNull    bool
+		target    string
+	}{
+		{
+			name:      "Absent",
+			input:     `{}`,
+			isPresent: false,
+			isNull:    false,
+			target:    "initial",
+		},
+		{
+			name:      "Null",
+			input:     `{"name":null}`,
+			isPresent: true,
+			isNull:    true,
+			target:    "",
+		},
+		{
+			name:      "Value",
+			input:     `{"name":"artem"}`,
+			isPresent: true,
+			isNull:    false,
+			target:    "artem",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var request patchRequest
+			if err := json.Unmarshal([]byte(testCase.input), &request); err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if request.Name.IsPresent() != testCase.isPresent || request.Name.IsNull() != testCase.isNull {
+				t.Logf("unexpected field state: %s", request.Name.String())
+				t.Fail()
+			}
+
+			target := "initial"
+			request.Name.ApplyTo(&target)
+			if target != testCase.target {
+				t.Logf("expected: %s, got: %s", testCase.target, target)
+				t.Fail()
+			}
+
+			output, err := json.Marshal(request)
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if string(output) != testCase.input {
+				t.Logf("expected: %s, got: %s", testCase.input, output)
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package optional
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+var _ fmt.Formatter = T[struct{}]{}
+var _ slog.LogValuer = T[struct{}]{}
+var _ fmt.Formatter = Formatted[struct{}]{}
+var _ fmt.Stringer = Formatted[struct{}]{}
+
+// Placeholders are texts which None and absent Field are formatted with, empty ones fall back to "NULL" and "ABSENT"
+type Placeholders struct {
+	Null   string
+	Absent string
+}
+
+// Formatted formats optional or field with custom placeholders, it is created by WithPlaceholders
+type Formatted[V any] struct {
+	optional     T[V]
+	isAbsent     bool
+	placeholders Placeholders
+}
+
+// WithPlaceholders wraps optional to be formatted with the given placeholders at the call site
+func (t T[V]) WithPlaceholders(placeholders Placeholders) Formatted[V] {
+	return Formatted[V]{optional: t, placeholders: placeholders}
+}
+
+// WithPlaceholders wraps field to be formatted with the given placeholders at the call site
+func (f Field[V]) WithPlaceholders(placeholders Placeholders) Formatted[V] {
+	return Formatted[V]{optional: f.optional, isAbsent: !f.isPresent, placeholders: placeholders}
+}
+
+func (f Formatted[V]) Format(state fmt.State, verb rune) {
+	if f.isAbsent {
+		placeholder := f.placeholders.Absent
+		if placeholder == "" {
+			placeholder = absentPlaceholder
+		}
+
+		fmt.Fprintf(state, fmt.FormatString(state, 's'), placeholder)
+		return
+	}
+
+	placeholder := f.placeholders.Null
+	if placeholder == "" {
+		placeholder = nullPlaceholder
+	}
+
+	f.optional.format(state, verb, placeholder)
+}
+
+func (f Formatted[V]) String() string {
+	return fmt.Sprint(f)
+}
+
+// Format formats inner value with the same verb and flags, None is formatted as NULL.
+// %#v produces Go syntax: optional.Some[int](42) or optional.None[int]()
+func (t T[V]) Format(f fmt.State, verb rune) {
+	t.format(f, verb, nullPlaceholder)
+}
+
+func (t T[V]) format(f fmt.State, verb rune, placeholder string) {
+	if verb == 'v' && f.Flag('#') {
+		typeName := reflect.TypeFor[V]().String()
+		if !t.isNotNull {
+			fmt.Fprintf(f, "optional.None[%s]()", typeName)
+			return
+		}
+
+		fmt.Fprintf(f, "optional.Some[%s](%#v)", typeName, t.value)
+		return
+	}
+
+	if !t.isNotNull {
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), placeholder)
+		return
+	}
+
+	fmt.Fprintf(f, fmt.FormatString(f, verb), t.value)
+}
+
+// LogValue logs None as null and Some as inner value, resolving it if it's slog.LogValuer itself
+func (t T[V]) LogValue() slog.Value {
+	if !t.isNotNull {
+		return slog.AnyValue(nil)
+	}
+
+	if valuer, ok := any(t.value).(slog.LogValuer); ok {
+		return valuer.LogValue()
+	}
+
+	return slog.AnyValue(t.value)
+}
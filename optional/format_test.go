@@ -0,0 +1,91 @@
+package optional_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+)
+
+type point struct {
+	X, Y int
+}
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		format string
+		value  any
+		output string
+	}{
+		{"%v", optional.Some(42), "42"},
+		{"%v", optional.None[int](), "NULL"},
+		{"%5v", optional.None[int](), " NULL"},
+		{"%03d", optional.Some(7), "007"},
+		{"%+v", optional.Some(point{1, 2}), "{X:1 Y:2}"},
+		{"%+v", optional.None[point](), "NULL"},
+		{"%q", optional.Some("text"), `"text"`},
+		{"%#v", optional.Some(int64(42)), "optional.Some[int64](42)"},
+		{"%#v", optional.None[string](), "optional.None[string]()"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.format+testCase.output, func(t *testing.T) {
+			output := fmt.Sprintf(testCase.format, testCase.value)
+			if output != testCase.output {
+				t.Logf("expected: %s, got: %s", testCase.output, output)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestWithPlaceholders(t *testing.T) {
+	placeholders := optional.Placeholders{Null: "<none>", Absent: "<absent>"}
+
+	testCases := []struct {
+		format string
+		value  any
+		output string
+	}{
+		{"%v", optional.None[int]().WithPlaceholders(placeholders), "<none>"},
+		{"%8v", optional.None[int]().WithPlaceholders(placeholders), "  <none>"},
+		{"%03d", optional.Some(7).WithPlaceholders(placeholders), "007"},
+		{"%v", optional.None[int]().WithPlaceholders(optional.Placeholders{}), "NULL"},
+		{"%v", optional.AbsentField[int]().WithPlaceholders(placeholders), "<absent>"},
+		{"%v", optional.AbsentField[int]().WithPlaceholders(optional.Placeholders{}), "ABSENT"},
+		{"%v", optional.NullField[int]().WithPlaceholders(placeholders), "<none>"},
+		{"%v", optional.SomeField(42).WithPlaceholders(placeholders), "42"},
+		{"%v", optional.None[int](), "NULL"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.format+testCase.output, func(t *testing.T) {
+			output := fmt.Sprintf(testCase.format, testCase.value)
+			if output != testCase.output {
+				t.Logf("expected: %s, got: %s", testCase.output, output)
+				t.Fail()
+			}
+		})
+	}
+
+	if output := optional.None[int]().WithPlaceholders(placeholders).String(); output != "<none>" {
+		t.Logf("expected: <none>, got: %s", output)
+		t.Fail()
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	logger.Info("test", "name", optional.None[string](), "age", optional.Some(42))
+
+	output := buf.String()
+	if !strings.Contains(output, `"name":null`) || !strings.Contains(output, `"age":42`) {
+		t.Logf("unexpected output: %s", output)
+		t.Fail()
+	}
+}
@@ -0,0 +1,78 @@
+package optional
+
+import "github.com/leshless/golibrary/pair"
+
+func Map[A any, B any](t T[A], mapping func(a A) B) T[B] {
+	if !t.isNotNull {
+		return T[B]{}
+	}
+
+	return Some(mapping(t.value))
+}
+
+func FlatMap[A any, B any](t T[A], mapping func(a A) T[B]) T[B] {
+	if !t.isNotNull {
+		return T[B]{}
+	}
+
+	return mapping(t.value)
+}
+
+func Filter[V any](t T[V], predicate func(v V) bool) T[V] {
+	if !t.isNotNull || !predicate(t.value) {
+		return T[V]{}
+	}
+
+	return t
+}
+
+func OrElse[V any](t T[V], other T[V]) T[V] {
+	if t.isNotNull {
+		return t
+	}
+
+	return other
+}
+
+func OrElseGet[V any](t T[V], getOther func() T[V]) T[V] {
+	if t.isNotNull {
+		return t
+	}
+
+	return getOther()
+}
+
+func ValueOr[V any](t T[V], fallback V) V {
+	if t.isNotNull {
+		return t.value
+	}
+
+	return fallback
+}
+
+// MustValue panics if optional is None, so use it only when value presence is guaranteed
+func MustValue[V any](t T[V]) V {
+	if !t.isNotNull {
+		panic("optional: MustValue called on None")
+	}
+
+	return t.value
+}
+
+func Zip[A any, B any](a T[A], b T[B]) T[pair.T[A, B]] {
+	if !a.isNotNull || !b.isNotNull {
+		return T[pair.T[A, B]]{}
+	}
+
+	return Some(pair.New(a.value, b.value))
+}
+
+func First[V any](ts ...T[V]) T[V] {
+	for _, t := range ts {
+		if t.isNotNull {
+			return t
+		}
+	}
+
+	return T[V]{}
+}
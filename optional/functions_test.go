@@ -0,0 +1,133 @@
+package optional_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+	"github.com/leshless/golibrary/pair"
+)
+
+func TestMap(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  optional.T[int]
+		result optional.T[string]
+	}{
+		{
+			name:   "Some",
+			input:  optional.Some(42),
+			result: optional.Some("42"),
+		},
+		{
+			name:   "None",
+			input:  optional.None[int](),
+			result: optional.None[string](),
+		},
+		{
+			name:   "ZeroValue",
+			input:  optional.T[int]{},
+			result: optional.None[string](),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := optional.Map(testCase.input, strconv.Itoa)
+			if result != testCase.result {
+				t.Logf("expected: %s, got: %s", testCase.result.String(), result.String())
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestChain(t *testing.T) {
+	parse := func(s string) optional.T[int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return optional.None[int]()
+		}
+
+		return optional.Some(n)
+	}
+	isPositive := func(n int) bool {
+		return n > 0
+	}
+
+	testCases := []struct {
+		name   string
+		input  optional.T[string]
+		result int
+	}{
+		{
+			name:   "Valid",
+			input:  optional.Some("42"),
+			result: 42,
+		},
+		{
+			name:   "Negative",
+			input:  optional.Some("-42"),
+			result: 0,
+		},
+		{
+			name:   "Malformed",
+			input:  optional.Some("forty two"),
+			result: 0,
+		},
+		{
+			name:   "None",
+			input:  optional.None[string](),
+			result: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := optional.ValueOr(optional.Filter(optional.FlatMap(testCase.input, parse), isPositive), 0)
+			if result != testCase.result {
+				t.Logf("expected: %d, got: %d", testCase.result, result)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	result := optional.Zip(optional.Some(1), optional.Some("one"))
+	if expected := optional.Some(pair.New(1, "one")); result != expected {
+		t.Logf("expected: %s, got: %s", expected.String(), result.String())
+		t.Fail()
+	}
+
+	result = optional.Zip(optional.Some(1), optional.None[string]())
+	if !result.IsNull() {
+		t.Logf("expected: None, got: %s", result.String())
+		t.Fail()
+	}
+}
+
+func TestFirst(t *testing.T) {
+	result := optional.First(optional.None[int](), optional.Some(1), optional.Some(2))
+	if expected := optional.Some(1); result != expected {
+		t.Logf("expected: %s, got: %s", expected.String(), result.String())
+		t.Fail()
+	}
+
+	result = optional.First[int]()
+	if !result.IsNull() {
+		t.Logf("expected: None, got: %s", result.String())
+		t.Fail()
+	}
+}
+
+func TestMustValue(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Logf("expected panic")
+			t.Fail()
+		}
+	}()
+
+	optional.MustValue(optional.None[int]())
+}
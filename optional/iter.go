@@ -0,0 +1,86 @@
+package optional
+
+import (
+	"cmp"
+	"iter"
+)
+
+// All returns sequence yielding optional value if it's present
+func (t T[V]) All() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if t.isNotNull {
+			yield(t.value)
+		}
+	}
+}
+
+// Flatten skips None elements of the sequence
+func Flatten[V any](seq iter.Seq[T[V]]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for t := range seq {
+			if t.isNotNull && !yield(t.value) {
+				return
+			}
+		}
+	}
+}
+
+func CollectSome[V any](seq iter.Seq[T[V]]) []V {
+	vs := make([]V, 0)
+	for v := range Flatten(seq) {
+		vs = append(vs, v)
+	}
+
+	return vs
+}
+
+func FirstSome[V any](seq iter.Seq[T[V]]) T[V] {
+	for t := range seq {
+		if t.isNotNull {
+			return t
+		}
+	}
+
+	return T[V]{}
+}
+
+func Find[V any](seq iter.Seq[V], predicate func(v V) bool) T[V] {
+	for v := range seq {
+		if predicate(v) {
+			return Some(v)
+		}
+	}
+
+	return T[V]{}
+}
+
+func Last[V any](seq iter.Seq[V]) T[V] {
+	var last T[V]
+	for v := range seq {
+		last = Some(v)
+	}
+
+	return last
+}
+
+func Min[V cmp.Ordered](seq iter.Seq[V]) T[V] {
+	var result T[V]
+	for v := range seq {
+		if !result.isNotNull || cmp.Less(v, result.value) {
+			result = Some(v)
+		}
+	}
+
+	return result
+}
+
+func Max[V cmp.Ordered](seq iter.Seq[V]) T[V] {
+	var result T[V]
+	for v := range seq {
+		if !result.isNotNull || cmp.Less(result.value, v) {
+			result = Some(v)
+		}
+	}
+
+	return result
+}
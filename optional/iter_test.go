@@ -0,0 +1,107 @@
+package optional_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+)
+
+func TestAll(t *testing.T) {
+	count := 0
+	for range optional.None[int]().All() {
+		count++
+	}
+	for range optional.Some(0).All() {
+		count++
+	}
+
+	if count != 1 {
+		t.Logf("expected: 1, got: %d", count)
+		t.Fail()
+	}
+}
+
+func TestCollectSome(t *testing.T) {
+	input := []optional.T[int]{optional.Some(1), optional.None[int](), {}, optional.Some(3)}
+
+	result := optional.CollectSome(slices.Values(input))
+	if slices.Compare(result, []int{1, 3}) != 0 {
+		t.Logf("expected: %v, got: %v", []int{1, 3}, result)
+		t.Fail()
+	}
+
+	first := optional.FirstSome(slices.Values(input))
+	if first != optional.Some(1) {
+		t.Logf("expected: 1, got: %s", first.String())
+		t.Fail()
+	}
+}
+
+func TestSeqLookups(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []int
+		lookup func(input []int) optional.T[int]
+		result optional.T[int]
+	}{
+		{
+			name:  "Find",
+			input: []int{1, 2, 3, 4},
+			lookup: func(input []int) optional.T[int] {
+				return optional.Find(slices.Values(input), func(n int) bool { return n%2 == 0 })
+			},
+			result: optional.Some(2),
+		},
+		{
+			name:  "FindMissing",
+			input: []int{1, 3},
+			lookup: func(input []int) optional.T[int] {
+				return optional.Find(slices.Values(input), func(n int) bool { return n%2 == 0 })
+			},
+			result: optional.None[int](),
+		},
+		{
+			name:  "Last",
+			input: []int{1, 2, 3},
+			lookup: func(input []int) optional.T[int] {
+				return optional.Last(slices.Values(input))
+			},
+			result: optional.Some(3),
+		},
+		{
+			name:  "Min",
+			input: []int{3, -1, 2},
+			lookup: func(input []int) optional.T[int] {
+				return optional.Min(slices.Values(input))
+			},
+			result: optional.Some(-1),
+		},
+		{
+			name:  "Max",
+			input: []int{3, -1, 2},
+			lookup: func(input []int) optional.T[int] {
+				return optional.Max(slices.Values(input))
+			},
+			result: optional.Some(3),
+		},
+		{
+			name:  "MaxEmpty",
+			input: nil,
+			lookup: func(input []int) optional.T[int] {
+				return optional.Max(slices.Values(input))
+			},
+			result: optional.None[int](),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.lookup(testCase.input)
+			if result != testCase.result {
+				t.Logf("expected: %s, got: %s", testCase.result.String(), result.String())
+				t.Fail()
+			}
+		})
+	}
+}
@@ -1,14 +1,18 @@
 package optional
 
+//go:generate go run github.com/leshless/golibrary/optional_generator
+
 import (
 	"encoding/json"
 	"fmt"
 )
 
 const (
-	nullPlaceholder = "NULL"
+	nullPlaceholder   = "NULL"
+	absentPlaceholder = "ABSENT"
 )
 
+// T holds either a value or nothing. None always holds zero V, which keeps T comparable whenever V is
 type T[V any] struct {
 	value     V
 	isNotNull bool
@@ -16,7 +20,7 @@ type T[V any] struct {
 
 var _ fmt.Stringer = (*T[struct{}])(nil)
 var _ json.Unmarshaler = (*T[struct{}])(nil)
-var _ json.Marshaler = (*T[struct{}])(nil)
+var _ json.Marshaler = T[struct{}]{}
 
 func Some[V any](value V) T[V] {
 	return T[V]{
@@ -44,7 +48,7 @@ func (t *T[V]) IsNull() bool {
 	return !t.isNotNull
 }
 
-func (t *T[V]) Value() (V, bool) {
+func (t *T[V]) Get() (V, bool) {
 	return t.value, t.isNotNull
 }
 
@@ -72,10 +76,18 @@ func (t *T[V]) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	return json.Unmarshal(data, &t.value)
+	var value V
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*t = Some(value)
+	return nil
 }
 
-func (t *T[V]) MarshalJSON() ([]byte, error) {
+// MarshalJSON has value receiver, so that optionals stored by value in maps, slices and
+// non-addressable structs are still encoded properly
+func (t T[V]) MarshalJSON() ([]byte, error) {
 	if !t.isNotNull {
 		return []byte("null"), nil
 	}
@@ -0,0 +1,431 @@
+package optional_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+)
+
+type user struct {
+	Name optional.T[string] `json:"name"`
+	Age  optional.T[int]    `json:"age"`
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	age := optional.Some(42)
+
+	testCases := []struct {
+		name   string
+		input  string
+		target func() any
+	}{
+		{
+			name:   "Some",
+			input:  `42`,
+			target: func() any { return new(optional.T[int]) },
+		},
+		{
+			name:   "None",
+			input:  `null`,
+			target: func() any { return new(optional.T[int]) },
+		},
+		{
+			name:   "Struct",
+			input:  `{"name":"artem","age":null}`,
+			target: func() any { return new(user) },
+		},
+		{
+			name:   "OptionalStruct",
+			input:  `{"name":null,"age":42}`,
+			target: func() any { return new(optional.T[user]) },
+		},
+		{
+			name:   "Nested",
+			input:  `42`,
+			target: func() any { return new(optional.T[optional.T[int]]) },
+		},
+		{
+			name:   "Slice",
+			input:  `[1,null,3]`,
+			target: func() any { return new([]optional.T[int]) },
+		},
+		{
+			name:   "Map",
+			input:  `{"a":1,"b":null}`,
+			target: func() any { return new(map[string]optional.T[int]) },
+		},
+		{
+			name:   "Pointer",
+			input:  `42`,
+			target: func() any { return new(*optional.T[int]) },
+		},
+		{
+			name:   "PointerNull",
+			input:  `null`,
+			target: func() any { return new(*optional.T[int]) },
+		},
+		{
+			name:   "PointerSlice",
+			input:  `[42,null]`,
+			target: func() any { return &[]*optional.T[int]{&age, &age} },
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			target := testCase.target()
+			if err := json.Unmarshal([]byte(testCase.input), target); err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			output, err := json.Marshal(reflect.ValueOf(target).Elem().Interface())
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if string(output) != testCase.input {
+				t.Logf("expected: %s, got: %s", testCase.input, output)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONPresence(t *testing.T) {
+	var result optional.T[int]
+	if err := json.Unmarshal([]byte(`42`), &result); err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	if result.IsNull() {
+		t.Logf("expected: 42, got: %s", result.String())
+		t.Fail()
+	}
+
+	if err := json.Unmarshal([]byte(`"42"`), &result); err == nil {
+		t.Logf("expected error, got: %s", result.String())
+		t.Fail()
+	}
+
+	if result != optional.Some(42) {
+		t.Logf("failed unmarshalling must not modify value, got: %s", result.String())
+		t.Fail()
+	}
+}
+
+type strictRequest struct {
+	User  optional.Strict[user] `json:"user"`
+	Users []optional.T[user]    `json:"users"`
+	Patch optional.Field[user]  `json:"patch"`
+	Pair  [2]user               `json:"pair"`
+	ByID  map[int]user          `json:"by_id"`
+	ByIP  map[netip.Addr]user   `json:"by_ip"`
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		path  string
+	}{
+		{
+			name:  "Valid",
+			input: `{"user":{"name":"artem","age":42},"users":[null,{"name":"artem"}]}`,
+		},
+		{
+			name:  "Null",
+			input: `{"user":null}`,
+		},
+		{
+			name:  "UnknownField",
+			input: `{"user":{"nickname":"artem"}}`,
+			path:  "user.nickname",
+		},
+		{
+			name:  "TypeMismatch",
+			input: `{"user":{"age":"42"}}`,
+			path:  "user.age",
+		},
+		{
+			name:  "SliceElement",
+			input: `{"users":[{"name":"artem"},{"name":1}]}`,
+			path:  "users[1].name",
+		},
+		{
+			name:  "FieldUnknownField",
+			input: `{"patch":{"zzz":1}}`,
+			path:  "patch.zzz",
+		},
+		{
+			name:  "Containers",
+			input: `{"pair":[{"name":"artem"}],"by_id":{"1":{"age":42}},"by_ip":{"127.0.0.1":{}}}`,
+		},
+		{
+			name:  "ArrayElement",
+			input: `{"pair":[{"x":1}]}`,
+			path:  "pair[0].x",
+		},
+		{
+			name:  "IntKeyMapValue",
+			input: `{"by_id":{"1":{"x":1}}}`,
+			path:  "by_id.1.x",
+		},
+		{
+			name:  "IntKeyMalformed",
+			input: `{"by_id":{"a":{}}}`,
+			path:  "by_id.a",
+		},
+		{
+			name:  "TextKeyMapValue",
+			input: `{"by_ip":{"127.0.0.1":{"x":1}}}`,
+			path:  "by_ip.127.0.0.1.x",
+		},
+		{
+			name:  "TextKeyMalformed",
+			input: `{"by_ip":{"localhost":{}}}`,
+			path:  "by_ip.localhost",
+		},
+		{
+			name:  "NotAnObject",
+			input: `{"users":[42]}`,
+			path:  "users[0]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var request strictRequest
+			err := optional.UnmarshalStrict([]byte(testCase.input), &request)
+
+			if testCase.path == "" {
+				if err != nil {
+					t.Logf("unexpected error: %s", err)
+					t.Fail()
+				}
+
+				return
+			}
+
+			var decodeErr *optional.DecodeError
+			if !errors.As(err, &decodeErr) || decodeErr.Path != testCase.path {
+				t.Logf("expected error at: %s, got: %v", testCase.path, err)
+				t.Fail()
+			}
+		})
+	}
+}
+
+type strictEmbedded struct {
+	A int `json:"a"`
+}
+
+type strictEmbeddedRequest struct {
+	*strictEmbedded
+	Name string
+	NAME string
+}
+
+func TestUnmarshalStrictEmbedded(t *testing.T) {
+	type Embedded struct {
+		A int `json:"a"`
+	}
+
+	type exportedRequest struct {
+		*Embedded
+	}
+
+	t.Run("ExportedPointer", func(t *testing.T) {
+		var request exportedRequest
+		if err := optional.UnmarshalStrict([]byte(`{"a":1}`), &request); err != nil || request.Embedded == nil || request.A != 1 {
+			t.Logf("expected embedded pointer to be allocated, got: %v", err)
+			t.Fail()
+		}
+	})
+
+	t.Run("UnexportedPointer", func(t *testing.T) {
+		var request strictEmbeddedRequest
+		if err := optional.UnmarshalStrict([]byte(`{"a":1}`), &request); err == nil {
+			t.Logf("expected error for nil unexported embedded pointer")
+			t.Fail()
+		}
+
+		request = strictEmbeddedRequest{strictEmbedded: &strictEmbedded{}}
+		if err := optional.UnmarshalStrict([]byte(`{"a":1}`), &request); err != nil || request.A != 1 {
+			t.Logf("expected: 1, got: %v", err)
+			t.Fail()
+		}
+	})
+
+	t.Run("CaseInsensitive", func(t *testing.T) {
+		for range 10 {
+			var request strictEmbeddedRequest
+			if err := optional.UnmarshalStrict([]byte(`{"name":"artem"}`), &request); err != nil || request.Name != "artem" {
+				t.Logf("expected first declared field to be matched, got: %+v, %v", request, err)
+				t.FailNow()
+			}
+		}
+	})
+}
+
+func TestStrict(t *testing.T) {
+	var request strictRequest
+	err := json.Unmarshal([]byte(`{"user":{"nickname":"artem"}}`), &request)
+
+	var decodeErr *optional.DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Path != "nickname" {
+		t.Logf("expected error at: nickname, got: %v", err)
+		t.Fail()
+	}
+
+	err = json.Unmarshal([]byte(`{"user":{"name":"artem"}}`), &request)
+	if err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	if request.User.IsNull() {
+		t.Logf("expected user to be decoded")
+		t.Fail()
+	}
+}
+
+func FuzzJSONRoundTrip(f *testing.F) {
+	for _, seed := range []string{`null`, `0`, `-1`, `42`, `"42"`, `[]`, `{}`, ` 7 `} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var first optional.T[int]
+		if err := json.Unmarshal(data, &first); err != nil {
+			return
+		}
+
+		output, err := json.Marshal(first)
+		if err != nil {
+			t.Fatalf("marshalling %s: %s", first.String(), err)
+		}
+
+		var second optional.T[int]
+		if err := json.Unmarshal(output, &second); err != nil {
+			t.Fatalf("unmarshalling %s: %s", output, err)
+		}
+
+		if first != second {
+			t.Fatalf("round trip mismatch: %s != %s", first.String(), second.String())
+		}
+	})
+}
+
+// fuzzStableJSON checks that JSON accepted into V is marshalled into output which decodes back into equal value
+func fuzzStableJSON[V any](t *testing.T, data []byte) {
+	var first V
+	if err := json.Unmarshal(data, &first); err != nil {
+		return
+	}
+
+	output, err := json.Marshal(first)
+	if err != nil {
+		t.Fatalf("marshalling %#v: %s", first, err)
+	}
+
+	var second V
+	if err := json.Unmarshal(output, &second); err != nil {
+		t.Fatalf("unmarshalling %s: %s", output, err)
+	}
+
+	again, err := json.Marshal(second)
+	if err != nil {
+		t.Fatalf("marshalling %#v: %s", second, err)
+	}
+
+	if string(output) != string(again) {
+		t.Fatalf("round trip mismatch: %s != %s", output, again)
+	}
+}
+
+func FuzzJSONNested(f *testing.F) {
+	for _, seed := range []string{`null`, `42`, `[null]`, `[[1]]`} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(fuzzStableJSON[optional.T[optional.T[int]]])
+}
+
+func FuzzJSONSlice(f *testing.F) {
+	for _, seed := range []string{`null`, `[]`, `[null,1]`, `[1,"2"]`} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(fuzzStableJSON[[]optional.T[int]])
+}
+
+func FuzzJSONMap(f *testing.F) {
+	for _, seed := range []string{`null`, `{}`, `{"a":null,"b":1}`, `{"a":[]}`} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(fuzzStableJSON[map[string]optional.T[int]])
+}
+
+func FuzzJSONPointer(f *testing.F) {
+	for _, seed := range []string{`null`, `0`, `42`, `"42"`} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(fuzzStableJSON[*optional.T[int]])
+}
+
+func FuzzUnmarshalStrict(f *testing.F) {
+	for _, seed := range []string{
+		`{}`,
+		`{"user":{"name":"artem","age":42},"users":[null,{"name":"artem"}]}`,
+		`{"patch":null,"pair":[{}],"by_id":{"1":{"age":1}},"by_ip":{"::1":{}}}`,
+		`{"user":{"nickname":"artem"}}`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var first strictRequest
+		if err := optional.UnmarshalStrict(data, &first); err != nil {
+			var decodeErr *optional.DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("expected decode error, got: %v", err)
+			}
+
+			return
+		}
+
+		output, err := json.Marshal(first)
+		if err != nil {
+			t.Fatalf("marshalling %+v: %s", first, err)
+		}
+
+		var second strictRequest
+		if err := optional.UnmarshalStrict(output, &second); err != nil {
+			t.Fatalf("unmarshalling %s: %s", output, err)
+		}
+
+		if again, _ := json.Marshal(second); string(again) != string(output) {
+			t.Fatalf("round trip mismatch: %s != %s", output, again)
+		}
+	})
+}
+
+func TestTypeAliases(t *testing.T) {
+	name := optional.SomeString("artem")
+	timeout := optional.DurationFromPointer(nil)
+
+	var aliased optional.String = name
+	if aliased.IsNull() || !timeout.IsNull() {
+		t.Logf("unexpected alias state: %s, %s", aliased.String(), timeout.String())
+		t.Fail()
+	}
+}
@@ -0,0 +1,466 @@
+package optional
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+const (
+	patchTag = "patch"
+)
+
+// TypeMismatchError is returned when patch value can't be applied to the destination field
+type TypeMismatchError struct {
+	Path string
+	Src  reflect.Type
+	Dst  reflect.Type
+}
+
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("optional: patching %s: can't apply %s to %s", e.Path, e.Src, e.Dst)
+}
+
+// UnknownFieldError is returned when patch field has no matching destination field
+type UnknownFieldError struct {
+	Path string
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("optional: patching %s: unknown field", e.Path)
+}
+
+// UnsupportedFieldError is returned when patch struct contains field which isn't an optional, a Field or a nested struct
+type UnsupportedFieldError struct {
+	Path string
+	Type reflect.Type
+}
+
+func (e *UnsupportedFieldError) Error() string {
+	return fmt.Sprintf("optional: patching %s: patch field of type %s must be an optional, a field or a struct", e.Path, e.Type)
+}
+
+// anyOptional lets reflection based code work with optionals without knowing V
+type anyOptional interface {
+	anyValue() (any, bool)
+	valueType() reflect.Type
+}
+
+type anyOptionalSetter interface {
+	setAny(value any)
+}
+
+type anyField interface {
+	anyOptional
+	isFieldPresent() bool
+}
+
+var anyOptionalType = reflect.TypeFor[anyOptional]()
+var anyOptionalSetterType = reflect.TypeFor[anyOptionalSetter]()
+var anyFieldType = reflect.TypeFor[anyField]()
+
+func (t T[V]) anyValue() (any, bool) {
+	return t.value, t.isNotNull
+}
+
+func (t T[V]) valueType() reflect.Type {
+	return reflect.TypeFor[V]()
+}
+
+func (t *T[V]) setAny(value any) {
+	if value == nil {
+		*t = T[V]{}
+		return
+	}
+
+	*t = Some(value.(V))
+}
+
+func (f Field[V]) anyValue() (any, bool) {
+	return f.optional.value, f.optional.isNotNull
+}
+
+func (f Field[V]) valueType() reflect.Type {
+	return reflect.TypeFor[V]()
+}
+
+func (f Field[V]) isFieldPresent() bool {
+	return f.isPresent
+}
+
+// setAny makes field present, nil value makes it null
+func (f *Field[V]) setAny(value any) {
+	if value == nil {
+		*f = NullField[V]()
+		return
+	}
+
+	*f = SomeField(value.(V))
+}
+
+// Patch applies every Some field of patch struct to the field of dst struct with the same name,
+// or the name given by `patch:"Name"` tag, `patch:"-"` skips the field. Field values are applied as present,
+// so explicit nulls reset destination. Nested structs of optionals are applied recursively, as well as optionals of structs
+// which are not assignable to destination. Destinations which are optionals of structs are patched in place, None ones
+// starting from zero value. Patch struct may contain only optionals, Fields and nested structs, other fields
+// are reported with UnsupportedFieldError. Returns paths of fields which values were actually changed
+func Patch(dst any, patch any) ([]string, error) {
+	dstValue := reflect.ValueOf(dst)
+	if dstValue.Kind() != reflect.Pointer || dstValue.IsNil() || dstValue.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("optional: patch destination must be a non-nil pointer to struct")
+	}
+
+	patchValue := reflect.Indirect(reflect.ValueOf(patch))
+	if patchValue.Kind() != reflect.Struct {
+		return nil, errors.New("optional: patch must be a struct or a pointer to struct")
+	}
+
+	changed := make([]string, 0)
+	if err := patchStruct(dstValue.Elem(), patchValue, "", &changed); err != nil {
+		return changed, err
+	}
+
+	return changed, nil
+}
+
+func patchStruct(dst reflect.Value, patch reflect.Value, prefix string, changed *[]string) error {
+	patchType := patch.Type()
+
+	for i := range patchType.NumField() {
+		patchField := patchType.Field(i)
+		if !patchField.IsExported() {
+			continue
+		}
+
+		name := patchField.Name
+		if tag := patchField.Tag.Get(patchTag); tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+
+		path := prefix + name
+
+		index, ok := lookupField(dst.Type(), name)
+		if !ok {
+			return &UnknownFieldError{Path: path}
+		}
+
+		dstField, err := fieldByIndex(dst, index)
+		if err != nil || !dstField.CanSet() {
+			return &UnknownFieldError{Path: path}
+		}
+
+		if err := applyPatch(dstField, patch.Field(i), path, changed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyPatch(dst reflect.Value, patch reflect.Value, path string, changed *[]string) error {
+	switch {
+	case patch.Type().Implements(anyFieldType):
+		field := patch.Interface().(anyField)
+		if !field.isFieldPresent() {
+			return nil
+		}
+
+		value, ok := field.anyValue()
+		if !ok {
+			return assignNull(dst, path, changed)
+		}
+
+		return assign(dst, reflect.ValueOf(&value).Elem().Elem(), field.valueType(), path, changed)
+	case patch.Type().Implements(anyOptionalType):
+		optional := patch.Interface().(anyOptional)
+
+		value, ok := optional.anyValue()
+		if !ok {
+			return nil
+		}
+
+		return assign(dst, reflect.ValueOf(&value).Elem().Elem(), optional.valueType(), path, changed)
+	case patch.Kind() == reflect.Struct:
+		if isOptionalOfStruct(dst.Type()) {
+			return patchOptional(dst, patch, path, changed)
+		}
+
+		if dst.Kind() == reflect.Pointer && dst.Type().Elem().Kind() == reflect.Struct {
+			if dst.IsNil() {
+				dst.Set(reflect.New(dst.Type().Elem()))
+			}
+			dst = dst.Elem()
+		}
+
+		if dst.Kind() != reflect.Struct {
+			return &TypeMismatchError{Path: path, Src: patch.Type(), Dst: dst.Type()}
+		}
+
+		return patchStruct(dst, patch, path+".", changed)
+	default:
+		return &UnsupportedFieldError{Path: path, Type: patch.Type()}
+	}
+}
+
+// isOptionalOfStruct reports whether t is an optional or a field holding struct or pointer to struct
+func isOptionalOfStruct(t reflect.Type) bool {
+	if !reflect.PointerTo(t).Implements(anyOptionalSetterType) {
+		return false
+	}
+
+	valueType := reflect.Zero(t).Interface().(anyOptional).valueType()
+	if valueType.Kind() == reflect.Pointer {
+		valueType = valueType.Elem()
+	}
+
+	return valueType.Kind() == reflect.Struct
+}
+
+// patchOptional patches value held by optional dst, None is patched starting from zero value and becomes Some
+func patchOptional(dst reflect.Value, patch reflect.Value, path string, changed *[]string) error {
+	optional := dst.Interface().(anyOptional)
+
+	value := reflect.New(optional.valueType()).Elem()
+	current, ok := optional.anyValue()
+	if ok {
+		value.Set(reflect.ValueOf(current))
+	}
+
+	nested := make([]string, 0)
+	if err := applyPatch(value, patch, path, &nested); err != nil {
+		return err
+	}
+
+	if ok && len(nested) == 0 {
+		return nil
+	}
+
+	next := reflect.New(dst.Type())
+	next.Interface().(anyOptionalSetter).setAny(value.Interface())
+	dst.Set(next.Elem())
+
+	if len(nested) == 0 {
+		nested = append(nested, path)
+	}
+
+	*changed = append(*changed, nested...)
+	return nil
+}
+
+// assign sets dst to value, valueType is passed explicitly since value may be invalid for nil interfaces
+func assign(dst reflect.Value, value reflect.Value, valueType reflect.Type, path string, changed *[]string) error {
+	if !value.IsValid() {
+		value = reflect.Zero(valueType)
+	}
+
+	var next reflect.Value
+
+	switch {
+	case valueType.AssignableTo(dst.Type()):
+		next = value
+	case reflect.PointerTo(dst.Type()).Implements(anyOptionalSetterType) && reflect.Zero(dst.Type()).Interface().(anyOptional).valueType() == valueType:
+		next = reflect.New(dst.Type())
+		next.Interface().(anyOptionalSetter).setAny(value.Interface())
+		next = next.Elem()
+	case dst.Kind() == reflect.Pointer && valueType.AssignableTo(dst.Type().Elem()):
+		next = reflect.New(dst.Type().Elem())
+		next.Elem().Set(value)
+	case valueType.Kind() == reflect.Struct:
+		return applyPatch(dst, value, path, changed)
+	default:
+		return &TypeMismatchError{Path: path, Src: valueType, Dst: dst.Type()}
+	}
+
+	if !reflect.DeepEqual(dst.Interface(), next.Interface()) {
+		dst.Set(next)
+		*changed = append(*changed, path)
+	}
+
+	return nil
+}
+
+func assignNull(dst reflect.Value, path string, changed *[]string) error {
+	if dst.IsZero() {
+		return nil
+	}
+
+	dst.SetZero()
+	*changed = append(*changed, path)
+
+	return nil
+}
+
+// Diff builds patch of type P, which being applied to old turns it into new.
+// Every optional field of P is set to the value of matching field of new if it differs from the old one,
+// nested structs of P and optionals of them are filled recursively, comparing values held by old and new optionals.
+// Nulls are only expressed by Field patch fields, since None optional means no change
+func Diff[P any](old any, new any) (P, error) {
+	var patch P
+
+	patchValue := reflect.ValueOf(&patch).Elem()
+	if patchValue.Kind() != reflect.Struct {
+		return patch, errors.New("optional: patch must be a struct")
+	}
+
+	oldValue := reflect.Indirect(reflect.ValueOf(old))
+	newValue := reflect.Indirect(reflect.ValueOf(new))
+	if oldValue.Kind() != reflect.Struct || oldValue.Type() != newValue.Type() {
+		return patch, errors.New("optional: diffed values must be structs of the same type")
+	}
+
+	if err := diffStruct(patchValue, oldValue, newValue, ""); err != nil {
+		return patch, err
+	}
+
+	return patch, nil
+}
+
+func diffStruct(patch reflect.Value, old reflect.Value, new reflect.Value, prefix string) error {
+	patchType := patch.Type()
+
+	for i := range patchType.NumField() {
+		patchField := patchType.Field(i)
+		if !patchField.IsExported() {
+			continue
+		}
+
+		name := patchField.Name
+		if tag := patchField.Tag.Get(patchTag); tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+
+		path := prefix + name
+
+		index, ok := lookupField(old.Type(), name)
+		if !ok {
+			return &UnknownFieldError{Path: path}
+		}
+
+		oldField := fieldOrZero(old, index)
+		newField := fieldOrZero(new, index)
+		if !oldField.CanInterface() {
+			return &UnknownFieldError{Path: path}
+		}
+
+		field := patch.Field(i)
+
+		switch {
+		case reflect.PointerTo(field.Type()).Implements(anyOptionalSetterType):
+			if reflect.DeepEqual(oldField.Interface(), newField.Interface()) {
+				continue
+			}
+
+			// optionals of structs are applied by Patch recursively, so they are diffed the same way
+			if valueType := field.Interface().(anyOptional).valueType(); valueType.Kind() == reflect.Struct {
+				oldValue, _ := unwrapOptional(oldField)
+				newValue, ok := unwrapOptional(newField)
+
+				if newValue.Kind() == reflect.Struct && !newValue.Type().AssignableTo(valueType) {
+					if !ok {
+						field.Addr().Interface().(anyOptionalSetter).setAny(nil)
+						continue
+					}
+
+					nested := reflect.New(valueType).Elem()
+					if err := diffStruct(nested, oldValue, newValue, path+"."); err != nil {
+						return err
+					}
+
+					field.Addr().Interface().(anyOptionalSetter).setAny(nested.Interface())
+					continue
+				}
+			}
+
+			if err := diffValue(field, newField, path); err != nil {
+				return err
+			}
+		case field.Kind() == reflect.Struct && oldField.Kind() == reflect.Struct:
+			if err := diffStruct(field, oldField, newField, path+"."); err != nil {
+				return err
+			}
+		case field.Kind() != reflect.Struct:
+			return &UnsupportedFieldError{Path: path, Type: field.Type()}
+		default:
+			return &TypeMismatchError{Path: path, Src: oldField.Type(), Dst: field.Type()}
+		}
+	}
+
+	return nil
+}
+
+// unwrapOptional returns value held by optional, None is returned as zero value, other values are returned as is
+func unwrapOptional(value reflect.Value) (reflect.Value, bool) {
+	if !value.Type().Implements(anyOptionalType) {
+		return value, true
+	}
+
+	optional := value.Interface().(anyOptional)
+
+	unwrapped := reflect.New(optional.valueType()).Elem()
+	v, ok := optional.anyValue()
+	if ok && v != nil {
+		unwrapped.Set(reflect.ValueOf(v))
+	}
+
+	return unwrapped, ok
+}
+
+// diffValue sets patch optional to value, unwrapping pointers and optionals, so that nil pointers and Nones become nulls
+func diffValue(patch reflect.Value, value reflect.Value, path string) error {
+	setter := patch.Addr().Interface().(anyOptionalSetter)
+	valueType := patch.Interface().(anyOptional).valueType()
+
+	switch {
+	case value.Type().AssignableTo(valueType):
+		setter.setAny(value.Interface())
+	case value.Type().Implements(anyOptionalType) && value.Interface().(anyOptional).valueType() == valueType:
+		v, ok := value.Interface().(anyOptional).anyValue()
+		if !ok {
+			v = nil
+		}
+
+		setter.setAny(v)
+	case value.Kind() == reflect.Pointer && value.Type().Elem().AssignableTo(valueType):
+		if value.IsNil() {
+			setter.setAny(nil)
+			return nil
+		}
+
+		setter.setAny(value.Elem().Interface())
+	default:
+		return &TypeMismatchError{Path: path, Src: value.Type(), Dst: valueType}
+	}
+
+	return nil
+}
+
+// lookupField resolves index of exported field by name, including ones promoted through embedded structs and struct pointers
+func lookupField(t reflect.Type, name string) ([]int, bool) {
+	field, ok := t.FieldByName(name)
+	if !ok || !field.IsExported() {
+		return nil, false
+	}
+
+	return field.Index, true
+}
+
+// fieldOrZero is reflect.Value.FieldByIndex which treats fields behind nil embedded pointers as zero values
+func fieldOrZero(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Zero(v.Type().Elem().FieldByIndex(index[i:]).Type)
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(x)
+	}
+
+	return v
+}
@@ -0,0 +1,373 @@
+package optional_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+)
+
+type address struct {
+	City   string
+	Street string
+}
+
+type account struct {
+	Name     string
+	Age      int
+	Email    *string
+	Nickname optional.T[string]
+	Address  address
+	Backup   address
+	Shipping optional.T[address]
+}
+
+type addressPatch struct {
+	City   optional.T[string]
+	Street optional.T[string]
+}
+
+type accountPatch struct {
+	Name     optional.T[string]
+	Years    optional.T[int] `patch:"Age"`
+	Email    optional.Field[string]
+	Nickname optional.T[string]
+	Address  addressPatch
+	Backup   optional.T[addressPatch]
+	Shipping optional.T[addressPatch]
+}
+
+func TestPatch(t *testing.T) {
+	email := "artem@example.com"
+
+	testCases := []struct {
+		name    string
+		patch   accountPatch
+		result  account
+		changed []string
+	}{
+		{
+			name:    "Empty",
+			patch:   accountPatch{},
+			result:  account{Name: "artem", Age: 20, Address: address{City: "Moscow"}},
+			changed: []string{},
+		},
+		{
+			name: "Fields",
+			patch: accountPatch{
+				Name:     optional.Some("artem"),
+				Years:    optional.Some(21),
+				Email:    optional.SomeField(email),
+				Nickname: optional.Some("leshless"),
+			},
+			result: account{
+				Name:     "artem",
+				Age:      21,
+				Email:    &email,
+				Nickname: optional.Some("leshless"),
+				Address:  address{City: "Moscow"},
+			},
+			changed: []string{"Age", "Email", "Nickname"},
+		},
+		{
+			name: "Nested",
+			patch: accountPatch{
+				Address: addressPatch{Street: optional.Some("Arbat")},
+				Backup:  optional.Some(addressPatch{City: optional.Some("Tver")}),
+			},
+			result: account{
+				Name:    "artem",
+				Age:     20,
+				Address: address{City: "Moscow", Street: "Arbat"},
+				Backup:  address{City: "Tver"},
+			},
+			changed: []string{"Address.Street", "Backup.City"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dst := account{Name: "artem", Age: 20, Address: address{City: "Moscow"}}
+
+			changed, err := optional.Patch(&dst, testCase.patch)
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if dst.Name != testCase.result.Name || dst.Age != testCase.result.Age ||
+				dst.Address != testCase.result.Address || dst.Backup != testCase.result.Backup ||
+				dst.Nickname != testCase.result.Nickname || (dst.Email == nil) != (testCase.result.Email == nil) {
+				t.Logf("expected: %+v, got: %+v", testCase.result, dst)
+				t.Fail()
+			}
+
+			if slices.Compare(changed, testCase.changed) != 0 {
+				t.Logf("expected changed: %v, got: %v", testCase.changed, changed)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestPatchErrors(t *testing.T) {
+	var dst account
+
+	_, err := optional.Patch(&dst, struct{ Age optional.T[string] }{optional.Some("20")})
+
+	var mismatchErr *optional.TypeMismatchError
+	if !errors.As(err, &mismatchErr) || mismatchErr.Path != "Age" {
+		t.Logf("expected type mismatch at Age, got: %v", err)
+		t.Fail()
+	}
+
+	_, err = optional.Patch(&dst, struct{ Phone optional.T[string] }{})
+
+	var unknownErr *optional.UnknownFieldError
+	if !errors.As(err, &unknownErr) || unknownErr.Path != "Phone" {
+		t.Logf("expected unknown field Phone, got: %v", err)
+		t.Fail()
+	}
+
+	_, err = optional.Patch(&dst, struct{ Name string }{"artem"})
+
+	var unsupportedErr *optional.UnsupportedFieldError
+	if !errors.As(err, &unsupportedErr) || unsupportedErr.Path != "Name" {
+		t.Logf("expected unsupported field Name, got: %v", err)
+		t.Fail()
+	}
+
+	if _, err := optional.Diff[struct{ Name string }](dst, dst); !errors.As(err, &unsupportedErr) {
+		t.Logf("expected unsupported field Name in diff, got: %v", err)
+		t.Fail()
+	}
+
+	if _, err := optional.Patch(dst, accountPatch{}); err == nil {
+		t.Logf("expected error for non-pointer destination")
+		t.Fail()
+	}
+}
+
+func TestPatchOptionalOfStruct(t *testing.T) {
+	testCases := []struct {
+		name    string
+		dst     optional.T[address]
+		patch   optional.T[addressPatch]
+		result  optional.T[address]
+		changed []string
+	}{
+		{
+			name:    "Some",
+			dst:     optional.Some(address{City: "Moscow", Street: "Lenina"}),
+			patch:   optional.Some(addressPatch{City: optional.Some("Tver")}),
+			result:  optional.Some(address{City: "Tver", Street: "Lenina"}),
+			changed: []string{"Shipping.City"},
+		},
+		{
+			name:    "None",
+			dst:     optional.None[address](),
+			patch:   optional.Some(addressPatch{City: optional.Some("Tver")}),
+			result:  optional.Some(address{City: "Tver"}),
+			changed: []string{"Shipping.City"},
+		},
+		{
+			name:    "NoneEmptyPatch",
+			dst:     optional.None[address](),
+			patch:   optional.Some(addressPatch{}),
+			result:  optional.Some(address{}),
+			changed: []string{"Shipping"},
+		},
+		{
+			name:    "Unchanged",
+			dst:     optional.Some(address{City: "Tver"}),
+			patch:   optional.Some(addressPatch{City: optional.Some("Tver")}),
+			result:  optional.Some(address{City: "Tver"}),
+			changed: []string{},
+		},
+		{
+			name:    "Absent",
+			dst:     optional.Some(address{City: "Tver"}),
+			patch:   optional.None[addressPatch](),
+			result:  optional.Some(address{City: "Tver"}),
+			changed: []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dst := account{Shipping: testCase.dst}
+
+			changed, err := optional.Patch(&dst, accountPatch{Shipping: testCase.patch})
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if dst.Shipping != testCase.result || slices.Compare(changed, testCase.changed) != 0 {
+				t.Logf("expected: %v, %v, got: %v, %v", testCase.result, testCase.changed, dst.Shipping, changed)
+				t.Fail()
+			}
+		})
+	}
+
+	t.Run("NestedStruct", func(t *testing.T) {
+		dst := account{}
+
+		_, err := optional.Patch(&dst, struct{ Shipping addressPatch }{addressPatch{Street: optional.Some("Arbat")}})
+		if err != nil || dst.Shipping != optional.Some(address{Street: "Arbat"}) {
+			t.Logf("unexpected result: %v, %v", dst.Shipping, err)
+			t.Fail()
+		}
+	})
+}
+
+type Contacts struct {
+	Phone string
+}
+
+type embeddingAccount struct {
+	*Contacts
+	Name string
+}
+
+type contactsPatch struct {
+	Phone optional.T[string]
+}
+
+func TestPatchEmbedded(t *testing.T) {
+	var dst embeddingAccount
+
+	changed, err := optional.Patch(&dst, contactsPatch{Phone: optional.Some("+7")})
+	if err != nil || dst.Contacts == nil || dst.Phone != "+7" || slices.Compare(changed, []string{"Phone"}) != 0 {
+		t.Logf("expected embedded pointer to be allocated, got: %+v, %v", dst, err)
+		t.Fail()
+	}
+
+	type hiddenAccount struct {
+		*address
+	}
+
+	var hidden hiddenAccount
+
+	_, err = optional.Patch(&hidden, struct{ City optional.T[string] }{optional.Some("Tver")})
+
+	var unknownErr *optional.UnknownFieldError
+	if !errors.As(err, &unknownErr) || unknownErr.Path != "City" {
+		t.Logf("expected unknown field City, got: %v", err)
+		t.Fail()
+	}
+}
+
+func TestDiffEmbedded(t *testing.T) {
+	old := embeddingAccount{Name: "artem"}
+	new := embeddingAccount{Contacts: &Contacts{Phone: "+7"}, Name: "artem"}
+
+	patch, err := optional.Diff[contactsPatch](old, new)
+	if err != nil || patch.Phone != optional.Some("+7") {
+		t.Logf("unexpected patch: %+v, %v", patch, err)
+		t.Fail()
+	}
+
+	patch, err = optional.Diff[contactsPatch](new, old)
+	if err != nil || patch.Phone != optional.Some("") {
+		t.Logf("expected nil embedded pointer to be diffed as zero value, got: %+v, %v", patch, err)
+		t.Fail()
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := account{Name: "artem", Age: 20, Address: address{City: "Moscow"}, Backup: address{City: "Moscow", Street: "Lenina"}}
+	new := account{
+		Name:     "artem",
+		Age:      21,
+		Address:  address{City: "Tver"},
+		Backup:   address{City: "Moscow", Street: "Mira"},
+		Shipping: optional.Some(address{City: "Tver"}),
+	}
+
+	patch, err := optional.Diff[accountPatch](old, new)
+	if err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	if !patch.Name.IsNull() || patch.Years != optional.Some(21) || patch.Address.City != optional.Some("Tver") ||
+		patch.Backup != optional.Some(addressPatch{Street: optional.Some("Mira")}) ||
+		patch.Shipping != optional.Some(addressPatch{City: optional.Some("Tver")}) {
+		t.Logf("unexpected patch: %+v", patch)
+		t.Fail()
+	}
+
+	changed, err := optional.Patch(&old, patch)
+	if err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	if old != new || slices.Compare(changed, []string{"Age", "Address.City", "Backup.Street", "Shipping.City"}) != 0 {
+		t.Logf("expected: %+v, got: %+v, changed: %v", new, old, changed)
+		t.Fail()
+	}
+}
+
+type shippingPatch struct {
+	Shipping optional.Field[addressPatch]
+}
+
+func TestDiffOptionalOfStruct(t *testing.T) {
+	testCases := []struct {
+		name  string
+		old   optional.T[address]
+		new   optional.T[address]
+		patch optional.Field[addressPatch]
+	}{
+		{
+			name:  "SomeToSome",
+			old:   optional.Some(address{City: "Moscow", Street: "Lenina"}),
+			new:   optional.Some(address{City: "Tver", Street: "Lenina"}),
+			patch: optional.SomeField(addressPatch{City: optional.Some("Tver")}),
+		},
+		{
+			name:  "NoneToSome",
+			old:   optional.None[address](),
+			new:   optional.Some(address{Street: "Arbat"}),
+			patch: optional.SomeField(addressPatch{Street: optional.Some("Arbat")}),
+		},
+		{
+			name:  "SomeToNone",
+			old:   optional.Some(address{City: "Tver"}),
+			new:   optional.None[address](),
+			patch: optional.NullField[addressPatch](),
+		},
+		{
+			name:  "Unchanged",
+			old:   optional.Some(address{City: "Tver"}),
+			new:   optional.Some(address{City: "Tver"}),
+			patch: optional.AbsentField[addressPatch](),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			old := account{Shipping: testCase.old}
+			new := account{Shipping: testCase.new}
+
+			patch, err := optional.Diff[shippingPatch](old, new)
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if patch.Shipping != testCase.patch {
+				t.Logf("expected: %v, got: %v", testCase.patch, patch.Shipping)
+				t.Fail()
+			}
+
+			if _, err := optional.Patch(&old, patch); err != nil || old != new {
+				t.Logf("expected: %+v, got: %+v, %v", new, old, err)
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+var _ sql.Scanner = (*T[struct{}])(nil)
+var _ driver.Valuer = T[struct{}]{}
+
+// Scan implements sql.Scanner. SQL NULL is scanned as None, any other value is converted
+// into V the same way database/sql does for plain destinations, including V's own Scanner
+func (t *T[V]) Scan(src any) error {
+	var null sql.Null[V]
+	if err := null.Scan(src); err != nil {
+		return err
+	}
+
+	*t = FromNull(null)
+	return nil
+}
+
+// Value implements driver.Valuer. None is stored as SQL NULL, any other value is converted
+// the same way database/sql does for plain arguments, including V's own Valuer.
+// Value receiver is required for database/sql to find it on optionals passed by value
+func (t T[V]) Value() (driver.Value, error) {
+	if !t.isNotNull {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(t.value)
+}
+
+// Null converts optional into sql.Null
+func (t *T[V]) Null() sql.Null[V] {
+	return sql.Null[V]{
+		V:     t.value,
+		Valid: t.isNotNull,
+	}
+}
+
+func FromNull[V any](null sql.Null[V]) T[V] {
+	if !null.Valid {
+		return T[V]{}
+	}
+
+	return T[V]{
+		value:     null.V,
+		isNotNull: true,
+	}
+}
@@ -0,0 +1,172 @@
+package optional_test
+
+import (
+	"database/sql/driver"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/leshless/golibrary/optional"
+)
+
+func TestScan(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name   string
+		src    any
+		result optional.T[int64]
+	}{
+		{
+			name:   "Null",
+			src:    nil,
+			result: optional.None[int64](),
+		},
+		{
+			name:   "Int",
+			src:    int64(42),
+			result: optional.Some[int64](42),
+		},
+		{
+			name:   "Bytes",
+			src:    []byte("42"),
+			result: optional.Some[int64](42),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := optional.Some[int64](-1)
+			if err := result.Scan(testCase.src); err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if result != testCase.result {
+				t.Logf("expected: %s, got: %s", testCase.result.String(), result.String())
+				t.Fail()
+			}
+		})
+	}
+
+	t.Run("Scanner", func(t *testing.T) {
+		var result optional.T[time.Time]
+		if err := result.Scan(now); err != nil {
+			t.Logf("unexpected error: %s", err)
+			t.FailNow()
+		}
+
+		if value, ok := result.Get(); !ok || !value.Equal(now) {
+			t.Logf("expected: %s, got: %s", now, result.String())
+			t.Fail()
+		}
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		var result optional.T[int64]
+		if err := result.Scan("not a number"); err == nil {
+			t.Logf("expected error, got: %s", result.String())
+			t.Fail()
+		}
+	})
+}
+
+func TestNull(t *testing.T) {
+	testCases := []struct {
+		name     string
+		optional optional.T[string]
+		result   any
+	}{
+		{
+			name:     "None",
+			optional: optional.None[string](),
+			result:   nil,
+		},
+		{
+			name:     "Some",
+			optional: optional.Some("value"),
+			result:   "value",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := testCase.optional.Null().Value()
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if result != testCase.result {
+				t.Logf("expected: %v, got: %v", testCase.result, result)
+				t.Fail()
+			}
+		})
+	}
+}
+
+type celsius int32
+
+type money struct {
+	cents int64
+}
+
+func (m money) Value() (driver.Value, error) {
+	return strconv.FormatInt(m.cents, 10), nil
+}
+
+func TestValue(t *testing.T) {
+	testCases := []struct {
+		name   string
+		valuer driver.Valuer
+		result driver.Value
+	}{
+		{
+			name:   "None",
+			valuer: optional.None[int](),
+			result: nil,
+		},
+		{
+			name:   "String",
+			valuer: optional.Some("value"),
+			result: "value",
+		},
+		{
+			name:   "ConvertedKind",
+			valuer: optional.Some(celsius(36)),
+			result: int64(36),
+		},
+		{
+			name:   "Valuer",
+			valuer: optional.Some(money{cents: 150}),
+			result: "150",
+		},
+		{
+			name:   "NilPointer",
+			valuer: optional.Some[*int](nil),
+			result: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := testCase.valuer.Value()
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if result != testCase.result {
+				t.Logf("expected: %v, got: %v", testCase.result, result)
+				t.Fail()
+			}
+		})
+	}
+
+	t.Run("Unsupported", func(t *testing.T) {
+		if _, err := optional.Some(struct{}{}).Value(); err == nil {
+			t.Logf("expected error for unsupported type")
+			t.Fail()
+		}
+	})
+}
@@ -0,0 +1,452 @@
+package optional
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Strict is a drop-in wrapper for T which decodes JSON strictly: unknown object fields are rejected
+// at any depth and every error is reported as *DecodeError naming the path relative to the wrapped value.
+// Use UnmarshalStrict to get paths relative to the document root
+type Strict[V any] struct {
+	T[V]
+}
+
+var _ json.Unmarshaler = (*Strict[struct{}])(nil)
+var _ json.Marshaler = Strict[struct{}]{}
+
+// DecodeError is returned by strict decoding, Path is a dot separated list of object keys and [index] segments
+type DecodeError struct {
+	Path string
+	Err  error
+}
+
+func (e *DecodeError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("optional: %s", e.Err)
+	}
+
+	return fmt.Sprintf("optional: decoding %s: %s", e.Path, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// strictUnmarshaler is implemented by optionals and fields, so that strictness propagates through nested ones
+type strictUnmarshaler interface {
+	unmarshalJSONStrict(data []byte) error
+}
+
+var strictUnmarshalerType = reflect.TypeFor[strictUnmarshaler]()
+var jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+func (s *Strict[V]) UnmarshalJSON(data []byte) error {
+	return s.unmarshalJSONStrict(data)
+}
+
+func (s *Strict[V]) unmarshalJSONStrict(data []byte) error {
+	return s.T.unmarshalJSONStrict(data)
+}
+
+func (t *T[V]) unmarshalJSONStrict(data []byte) error {
+	if string(data) == "null" {
+		*t = T[V]{}
+		return nil
+	}
+
+	var value V
+	if err := decodeStrict(data, reflect.ValueOf(&value).Elem()); err != nil {
+		return err
+	}
+
+	*t = Some(value)
+	return nil
+}
+
+// unmarshalJSONStrict makes field present, so absent fields are only those missing from the document
+func (f *Field[V]) unmarshalJSONStrict(data []byte) error {
+	var optional T[V]
+	if err := optional.unmarshalJSONStrict(data); err != nil {
+		return err
+	}
+
+	*f = FieldFromOptional(optional)
+	return nil
+}
+
+// UnmarshalStrict decodes JSON into v the same way as json.Unmarshal, but rejects unknown object fields
+// and reports errors as *DecodeError with the full path. Struct fields are matched by their json tag
+// names, the ",string" tag option isn't supported
+func UnmarshalStrict(data []byte, v any) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return &json.InvalidUnmarshalError{Type: reflect.TypeOf(v)}
+	}
+
+	return decodeStrict(data, value.Elem())
+}
+
+func decodeStrict(data []byte, v reflect.Value) error {
+	data = bytes.TrimSpace(data)
+
+	if reflect.PointerTo(v.Type()).Implements(strictUnmarshalerType) {
+		return v.Addr().Interface().(strictUnmarshaler).unmarshalJSONStrict(data)
+	}
+
+	if reflect.PointerTo(v.Type()).Implements(jsonUnmarshalerType) {
+		return decodeLeaf(data, v)
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if string(data) == "null" {
+			v.SetZero()
+			return nil
+		}
+
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		return decodeStrict(data, v.Elem())
+	case reflect.Struct:
+		return decodeStruct(data, v)
+	case reflect.Slice:
+		return decodeSlice(data, v)
+	case reflect.Array:
+		return decodeArray(data, v)
+	case reflect.Map:
+		if !isMapKeySupported(v.Type().Key()) {
+			return decodeLeaf(data, v)
+		}
+
+		return decodeMap(data, v)
+	default:
+		return decodeLeaf(data, v)
+	}
+}
+
+func decodeLeaf(data []byte, v reflect.Value) error {
+	if err := json.Unmarshal(data, v.Addr().Interface()); err != nil {
+		var decodeErr *DecodeError
+		if errors.As(err, &decodeErr) {
+			return decodeErr
+		}
+
+		return &DecodeError{Err: err}
+	}
+
+	return nil
+}
+
+func decodeStruct(data []byte, v reflect.Value) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var raws map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return typeError(err, v.Type())
+	}
+
+	fields := structFields(v.Type())
+
+	for key, raw := range raws {
+		index, ok := findField(fields, key)
+		if !ok {
+			return &DecodeError{Path: key, Err: errors.New("unknown field")}
+		}
+
+		field, err := fieldByIndex(v, index)
+		if err != nil {
+			return &DecodeError{Path: key, Err: err}
+		}
+
+		if err := decodeStrict(raw, field); err != nil {
+			return prefixPath(err, key)
+		}
+	}
+
+	return nil
+}
+
+// findField matches key exactly, falling back to the first case-insensitive match in declaration order like encoding/json does
+func findField(fields []strictField, key string) ([]int, bool) {
+	for _, field := range fields {
+		if field.name == key {
+			return field.index, true
+		}
+	}
+
+	for _, field := range fields {
+		if strings.EqualFold(field.name, key) {
+			return field.index, true
+		}
+	}
+
+	return nil, false
+}
+
+// fieldByIndex is reflect.Value.FieldByIndex which allocates nil embedded pointers instead of panicking
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot set embedded pointer to unexported struct type %s", v.Type().Elem())
+				}
+
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(x)
+	}
+
+	return v, nil
+}
+
+func decodeSlice(data []byte, v reflect.Value) error {
+	if string(data) == "null" {
+		v.SetZero()
+		return nil
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return typeError(err, v.Type())
+	}
+
+	slice := reflect.MakeSlice(v.Type(), len(raws), len(raws))
+	for i, raw := range raws {
+		if err := decodeStrict(raw, slice.Index(i)); err != nil {
+			return prefixPath(err, "["+strconv.Itoa(i)+"]")
+		}
+	}
+
+	v.Set(slice)
+	return nil
+}
+
+// decodeArray fills array like encoding/json does: extra elements are dropped and missing ones are zeroed
+func decodeArray(data []byte, v reflect.Value) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return typeError(err, v.Type())
+	}
+
+	for i := range v.Len() {
+		if i >= len(raws) {
+			v.Index(i).SetZero()
+			continue
+		}
+
+		if err := decodeStrict(raws[i], v.Index(i)); err != nil {
+			return prefixPath(err, "["+strconv.Itoa(i)+"]")
+		}
+	}
+
+	return nil
+}
+
+func decodeMap(data []byte, v reflect.Value) error {
+	if string(data) == "null" {
+		v.SetZero()
+		return nil
+	}
+
+	var raws map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return typeError(err, v.Type())
+	}
+
+	if v.IsNil() {
+		v.Set(reflect.MakeMapWithSize(v.Type(), len(raws)))
+	}
+
+	for key, raw := range raws {
+		elem := reflect.New(v.Type().Elem()).Elem()
+		if err := decodeStrict(raw, elem); err != nil {
+			return prefixPath(err, key)
+		}
+
+		k, err := parseMapKey(key, v.Type().Key())
+		if err != nil {
+			return &DecodeError{Path: key, Err: err}
+		}
+
+		v.SetMapIndex(k, elem)
+	}
+
+	return nil
+}
+
+// isMapKeySupported reports whether map keys of type t are decoded from object keys by encoding/json
+func isMapKeySupported(t reflect.Type) bool {
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseMapKey converts object key into map key the same way encoding/json does
+func parseMapKey(key string, t reflect.Type) (reflect.Value, error) {
+	k := reflect.New(t)
+	if unmarshaler, ok := k.Interface().(encoding.TextUnmarshaler); ok {
+		if err := unmarshaler.UnmarshalText([]byte(key)); err != nil {
+			return reflect.Value{}, err
+		}
+
+		return k.Elem(), nil
+	}
+
+	k = k.Elem()
+
+	switch t.Kind() {
+	case reflect.String:
+		k.SetString(key)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(key, 10, 64)
+		if err != nil || k.OverflowInt(n) {
+			return reflect.Value{}, &json.UnmarshalTypeError{Value: "number " + key, Type: t}
+		}
+
+		k.SetInt(n)
+	default:
+		n, err := strconv.ParseUint(key, 10, 64)
+		if err != nil || k.OverflowUint(n) {
+			return reflect.Value{}, &json.UnmarshalTypeError{Value: "number " + key, Type: t}
+		}
+
+		k.SetUint(n)
+	}
+
+	return k, nil
+}
+
+type strictField struct {
+	name  string
+	index []int
+}
+
+// structFields lists json fields in declaration order, fields of embedded structs and struct pointers are promoted unless shadowed
+func structFields(t reflect.Type) []strictField {
+	fields := make([]strictField, 0, t.NumField())
+	names := make(map[string]struct{})
+
+	for i := range t.NumField() {
+		if name, ok := fieldName(t.Field(i)); ok && name != "" {
+			names[name] = struct{}{}
+		}
+	}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		name, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+
+		if name != "" {
+			fields = append(fields, strictField{name: name, index: field.Index})
+			continue
+		}
+
+		embedded := field.Type
+		if embedded.Kind() == reflect.Pointer {
+			embedded = embedded.Elem()
+		}
+
+		for _, promoted := range structFields(embedded) {
+			if _, shadowed := names[promoted.name]; shadowed {
+				continue
+			}
+
+			names[promoted.name] = struct{}{}
+			fields = append(fields, strictField{name: promoted.name, index: append([]int{i}, promoted.index...)})
+		}
+	}
+
+	return fields
+}
+
+// fieldName returns json name of the field, empty name means embedded struct which fields should be promoted
+func fieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if field.Anonymous && name == "" {
+		embedded := field.Type
+		if embedded.Kind() == reflect.Pointer {
+			embedded = embedded.Elem()
+		}
+
+		if embedded.Kind() == reflect.Struct {
+			return "", true
+		}
+	}
+
+	if !field.IsExported() {
+		return "", false
+	}
+
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, true
+}
+
+// typeError replaces intermediate raw message type in decoding errors with the actual target type
+func typeError(err error, t reflect.Type) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		typeErr.Type = t
+	}
+
+	return &DecodeError{Err: err}
+}
+
+func prefixPath(err error, segment string) error {
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		return &DecodeError{Path: segment, Err: err}
+	}
+
+	switch {
+	case decodeErr.Path == "":
+		decodeErr.Path = segment
+	case strings.HasPrefix(decodeErr.Path, "["):
+		decodeErr.Path = segment + decodeErr.Path
+	default:
+		decodeErr.Path = segment + "." + decodeErr.Path
+	}
+
+	return decodeErr
+}
@@ -0,0 +1,320 @@
+/*
+AUTOGENERATED FILE. DO NOT EDIT.
+MANUAL CHANGES WILL BE LOST.
+
+Generated by tool: github.com/leshless/golibrary/optional_generator
+*/
+
+package optional
+
+import (
+	"time"
+)
+
+type String = T[string]
+
+func SomeString(value string) T[string] {
+	return Some(value)
+}
+
+func NoneString() T[string] {
+	return None[string]()
+}
+
+func StringFromPointer(pointer *string) T[string] {
+	return FromPointer(pointer)
+}
+
+type Bool = T[bool]
+
+func SomeBool(value bool) T[bool] {
+	return Some(value)
+}
+
+func NoneBool() T[bool] {
+	return None[bool]()
+}
+
+func BoolFromPointer(pointer *bool) T[bool] {
+	return FromPointer(pointer)
+}
+
+type Int = T[int]
+
+func SomeInt(value int) T[int] {
+	return Some(value)
+}
+
+func NoneInt() T[int] {
+	return None[int]()
+}
+
+func IntFromPointer(pointer *int) T[int] {
+	return FromPointer(pointer)
+}
+
+type Int8 = T[int8]
+
+func SomeInt8(value int8) T[int8] {
+	return Some(value)
+}
+
+func NoneInt8() T[int8] {
+	return None[int8]()
+}
+
+func Int8FromPointer(pointer *int8) T[int8] {
+	return FromPointer(pointer)
+}
+
+type Int16 = T[int16]
+
+func SomeInt16(value int16) T[int16] {
+	return Some(value)
+}
+
+func NoneInt16() T[int16] {
+	return None[int16]()
+}
+
+func Int16FromPointer(pointer *int16) T[int16] {
+	return FromPointer(pointer)
+}
+
+type Int32 = T[int32]
+
+func SomeInt32(value int32) T[int32] {
+	return Some(value)
+}
+
+func NoneInt32() T[int32] {
+	return None[int32]()
+}
+
+func Int32FromPointer(pointer *int32) T[int32] {
+	return FromPointer(pointer)
+}
+
+type Int64 = T[int64]
+
+func SomeInt64(value int64) T[int64] {
+	return Some(value)
+}
+
+func NoneInt64() T[int64] {
+	return None[int64]()
+}
+
+func Int64FromPointer(pointer *int64) T[int64] {
+	return FromPointer(pointer)
+}
+
+type Uint = T[uint]
+
+func SomeUint(value uint) T[uint] {
+	return Some(value)
+}
+
+func NoneUint() T[uint] {
+	return None[uint]()
+}
+
+func UintFromPointer(pointer *uint) T[uint] {
+	return FromPointer(pointer)
+}
+
+type Uint8 = T[uint8]
+
+func SomeUint8(value uint8) T[uint8] {
+	return Some(value)
+}
+
+func NoneUint8() T[uint8] {
+	return None[uint8]()
+}
+
+func Uint8FromPointer(pointer *uint8) T[uint8] {
+	return FromPointer(pointer)
+}
+
+type Uint16 = T[uint16]
+
+func SomeUint16(value uint16) T[uint16] {
+	return Some(value)
+}
+
+func NoneUint16() T[uint16] {
+	return None[uint16]()
+}
+
+func Uint16FromPointer(pointer *uint16) T[uint16] {
+	return FromPointer(pointer)
+}
+
+type Uint32 = T[uint32]
+
+func SomeUint32(value uint32) T[uint32] {
+	return Some(value)
+}
+
+func NoneUint32() T[uint32] {
+	return None[uint32]()
+}
+
+func Uint32FromPointer(pointer *uint32) T[uint32] {
+	return FromPointer(pointer)
+}
+
+type Uint64 = T[uint64]
+
+func SomeUint64(value uint64) T[uint64] {
+	return Some(value)
+}
+
+func NoneUint64() T[uint64] {
+	return None[uint64]()
+}
+
+func Uint64FromPointer(pointer *uint64) T[uint64] {
+	return FromPointer(pointer)
+}
+
+type Uintptr = T[uintptr]
+
+func SomeUintptr(value uintptr) T[uintptr] {
+	return Some(value)
+}
+
+func NoneUintptr() T[uintptr] {
+	return None[uintptr]()
+}
+
+func UintptrFromPointer(pointer *uintptr) T[uintptr] {
+	return FromPointer(pointer)
+}
+
+type Float32 = T[float32]
+
+func SomeFloat32(value float32) T[float32] {
+	return Some(value)
+}
+
+func NoneFloat32() T[float32] {
+	return None[float32]()
+}
+
+func Float32FromPointer(pointer *float32) T[float32] {
+	return FromPointer(pointer)
+}
+
+type Float64 = T[float64]
+
+func SomeFloat64(value float64) T[float64] {
+	return Some(value)
+}
+
+func NoneFloat64() T[float64] {
+	return None[float64]()
+}
+
+func Float64FromPointer(pointer *float64) T[float64] {
+	return FromPointer(pointer)
+}
+
+type Complex64 = T[complex64]
+
+func SomeComplex64(value complex64) T[complex64] {
+	return Some(value)
+}
+
+func NoneComplex64() T[complex64] {
+	return None[complex64]()
+}
+
+func Complex64FromPointer(pointer *complex64) T[complex64] {
+	return FromPointer(pointer)
+}
+
+type Complex128 = T[complex128]
+
+func SomeComplex128(value complex128) T[complex128] {
+	return Some(value)
+}
+
+func NoneComplex128() T[complex128] {
+	return None[complex128]()
+}
+
+func Complex128FromPointer(pointer *complex128) T[complex128] {
+	return FromPointer(pointer)
+}
+
+type Byte = T[byte]
+
+func SomeByte(value byte) T[byte] {
+	return Some(value)
+}
+
+func NoneByte() T[byte] {
+	return None[byte]()
+}
+
+func ByteFromPointer(pointer *byte) T[byte] {
+	return FromPointer(pointer)
+}
+
+type Rune = T[rune]
+
+func SomeRune(value rune) T[rune] {
+	return Some(value)
+}
+
+func NoneRune() T[rune] {
+	return None[rune]()
+}
+
+func RuneFromPointer(pointer *rune) T[rune] {
+	return FromPointer(pointer)
+}
+
+type Bytes = T[[]byte]
+
+func SomeBytes(value []byte) T[[]byte] {
+	return Some(value)
+}
+
+func NoneBytes() T[[]byte] {
+	return None[[]byte]()
+}
+
+func BytesFromPointer(pointer *[]byte) T[[]byte] {
+	return FromPointer(pointer)
+}
+
+type Time = T[time.Time]
+
+func SomeTime(value time.Time) T[time.Time] {
+	return Some(value)
+}
+
+func NoneTime() T[time.Time] {
+	return None[time.Time]()
+}
+
+func TimeFromPointer(pointer *time.Time) T[time.Time] {
+	return FromPointer(pointer)
+}
+
+type Duration = T[time.Duration]
+
+func SomeDuration(value time.Duration) T[time.Duration] {
+	return Some(value)
+}
+
+func NoneDuration() T[time.Duration] {
+	return None[time.Duration]()
+}
+
+func DurationFromPointer(pointer *time.Duration) T[time.Duration] {
+	return FromPointer(pointer)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"go/format"
+	"os"
+	"slices"
+	"text/template"
+
+	"github.com/leshless/golibrary/set"
+)
+
+//go:embed types.go.tpl
+var typesFile string
+var typesFileTemplate = template.Must(template.New("types").Parse(typesFile))
+
+const (
+	packageName    = "optional"
+	outputFileName = "types.gen.go"
+)
+
+type typeCriteria struct {
+	Name   string
+	Type   string
+	Import string
+}
+
+type fileCriteria struct {
+	PackageName string
+	Imports     []string
+	Types       []typeCriteria
+}
+
+// types is the single source of truth for generated optional aliases
+var types = []typeCriteria{
+	{Name: "String", Type: "string"},
+	{Name: "Bool", Type: "bool"},
+	{Name: "Int", Type: "int"},
+	{Name: "Int8", Type: "int8"},
+	{Name: "Int16", Type: "int16"},
+	{Name: "Int32", Type: "int32"},
+	{Name: "Int64", Type: "int64"},
+	{Name: "Uint", Type: "uint"},
+	{Name: "Uint8", Type: "uint8"},
+	{Name: "Uint16", Type: "uint16"},
+	{Name: "Uint32", Type: "uint32"},
+	{Name: "Uint64", Type: "uint64"},
+	{Name: "Uintptr", Type: "uintptr"},
+	{Name: "Float32", Type: "float32"},
+	{Name: "Float64", Type: "float64"},
+	{Name: "Complex64", Type: "complex64"},
+	{Name: "Complex128", Type: "complex128"},
+	{Name: "Byte", Type: "byte"},
+	{Name: "Rune", Type: "rune"},
+	{Name: "Bytes", Type: "[]byte"},
+	{Name: "Time", Type: "time.Time", Import: "time"},
+	{Name: "Duration", Type: "time.Duration", Import: "time"},
+}
+
+func main() {
+	imports := set.New[string]()
+	for _, t := range types {
+		if t.Import != "" {
+			imports.Add(t.Import)
+		}
+	}
+
+	sortedImports := imports.Slice()
+	slices.Sort(sortedImports)
+
+	criteria := fileCriteria{
+		PackageName: packageName,
+		Imports:     sortedImports,
+		Types:       types,
+	}
+
+	err := createTypesFileFromCriteria(criteria)
+	if err != nil {
+		fmt.Printf("Failed to create types file: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func createTypesFileFromCriteria(criteria fileCriteria) error {
+	var buf bytes.Buffer
+
+	err := typesFileTemplate.Execute(&buf, criteria)
+	if err != nil {
+		return fmt.Errorf("executing template: %w", err)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting source: %w", err)
+	}
+
+	err = os.WriteFile(outputFileName, source, 0o644)
+	if err != nil {
+		return fmt.Errorf("writing file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+/*
+AUTOGENERATED FILE. DO NOT EDIT.
+MANUAL CHANGES WILL BE LOST.
+
+Generated by tool: github.com/leshless/golibrary/optional_generator
+*/
+
+package {{.PackageName}}
+{{if .Imports}}
+import (
+	{{- range .Imports}}
+	"{{.}}"
+	{{- end}}
+)
+{{end}}
+{{- range .Types}}
+type {{.Name}} = T[{{.Type}}]
+
+func Some{{.Name}}(value {{.Type}}) T[{{.Type}}] {
+	return Some(value)
+}
+
+func None{{.Name}}() T[{{.Type}}] {
+	return None[{{.Type}}]()
+}
+
+func {{.Name}}FromPointer(pointer *{{.Type}}) T[{{.Type}}] {
+	return FromPointer(pointer)
+}
+{{end}}
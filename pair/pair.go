@@ -0,0 +1,17 @@
+package pair
+
+type T[A any, B any] struct {
+	First  A
+	Second B
+}
+
+func New[A any, B any](first A, second B) T[A, B] {
+	return T[A, B]{
+		First:  first,
+		Second: second,
+	}
+}
+
+func (p T[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
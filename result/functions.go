@@ -0,0 +1,56 @@
+package result
+
+import "errors"
+
+func Map[A any, B any](t T[A], mapping func(a A) B) T[B] {
+	if t.err != nil {
+		return Err[B](t.err)
+	}
+
+	return Ok(mapping(t.value))
+}
+
+func AndThen[A any, B any](t T[A], next func(a A) (B, error)) T[B] {
+	if t.err != nil {
+		return Err[B](t.err)
+	}
+
+	return From(next(t.value))
+}
+
+// Errors joins errors of all failed results, nil is returned if all of them are Ok
+func Errors[V any](ts []T[V]) error {
+	errs := make([]error, 0)
+	for _, t := range ts {
+		if t.err != nil {
+			errs = append(errs, t.err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func Partition[V any](ts []T[V]) ([]V, []error) {
+	oks := make([]V, 0, len(ts))
+	errs := make([]error, 0)
+	for _, t := range ts {
+		if t.err != nil {
+			errs = append(errs, t.err)
+			continue
+		}
+
+		oks = append(oks, t.value)
+	}
+
+	return oks, errs
+}
+
+// Collect returns all values if every result is Ok, otherwise joined errors are returned
+func Collect[V any](ts []T[V]) ([]V, error) {
+	oks, errs := Partition(ts)
+	if len(errs) != 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return oks, nil
+}
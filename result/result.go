@@ -0,0 +1,136 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/leshless/golibrary/optional"
+)
+
+// T holds either a value or an error, zero value is Ok holding zero V
+type T[V any] struct {
+	value V
+	err   error
+}
+
+var _ fmt.Stringer = T[struct{}]{}
+var _ json.Unmarshaler = (*T[struct{}])(nil)
+var _ json.Marshaler = T[struct{}]{}
+
+type jsonResult[V any] struct {
+	Value *V      `json:"value,omitempty"`
+	Error *string `json:"error,omitempty"`
+}
+
+// rawResult keeps value undecoded, so that explicit null value is told apart from missing one
+type rawResult struct {
+	Value json.RawMessage `json:"value"`
+	Error *string         `json:"error"`
+}
+
+func Ok[V any](value V) T[V] {
+	return T[V]{
+		value: value,
+	}
+}
+
+// Err panics on nil error, since such result would be indistinguishable from Ok
+func Err[V any](err error) T[V] {
+	if err == nil {
+		panic("result: Err called with nil error")
+	}
+
+	return T[V]{
+		err: err,
+	}
+}
+
+// From builds result from conventional (value, error) pair
+func From[V any](value V, err error) T[V] {
+	if err != nil {
+		return Err[V](err)
+	}
+
+	return Ok(value)
+}
+
+func (t T[V]) IsOk() bool {
+	return t.err == nil
+}
+
+func (t T[V]) IsErr() bool {
+	return t.err != nil
+}
+
+func (t T[V]) Err() error {
+	return t.err
+}
+
+func (t T[V]) Unwrap() (V, error) {
+	if t.err != nil {
+		var zero V
+		return zero, t.err
+	}
+
+	return t.value, nil
+}
+
+func (t T[V]) UnwrapOr(fallback V) V {
+	if t.err != nil {
+		return fallback
+	}
+
+	return t.value
+}
+
+// Optional drops the error, so that failed result becomes None
+func (t T[V]) Optional() optional.T[V] {
+	if t.err != nil {
+		return optional.None[V]()
+	}
+
+	return optional.Some(t.value)
+}
+
+func (t T[V]) String() string {
+	if t.err != nil {
+		return fmt.Sprintf("Err(%s)", t.err)
+	}
+
+	return fmt.Sprintf("Ok(%v)", t.value)
+}
+
+func (t *T[V]) UnmarshalJSON(data []byte) error {
+	var raw rawResult
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch {
+	case raw.Error != nil && raw.Value != nil:
+		return errors.New("both value and error are set")
+	case raw.Error != nil:
+		*t = Err[V](errors.New(*raw.Error))
+	case raw.Value != nil:
+		var value V
+		if err := json.Unmarshal(raw.Value, &value); err != nil {
+			return err
+		}
+
+		*t = Ok(value)
+	default:
+		return errors.New("neither value nor error is set")
+	}
+
+	return nil
+}
+
+func (t T[V]) MarshalJSON() ([]byte, error) {
+	if t.err != nil {
+		message := t.err.Error()
+		return json.Marshal(jsonResult[V]{Error: &message})
+	}
+
+	return json.Marshal(jsonResult[V]{Value: &t.value})
+}
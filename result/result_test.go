@@ -0,0 +1,145 @@
+package result_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/leshless/golibrary/result"
+)
+
+func TestJSON(t *testing.T) {
+	testCases := []struct {
+		name   string
+		result result.T[int]
+		output string
+	}{
+		{
+			name:   "Ok",
+			result: result.Ok(42),
+			output: `{"value":42}`,
+		},
+		{
+			name:   "OkZero",
+			result: result.Ok(0),
+			output: `{"value":0}`,
+		},
+		{
+			name:   "Err",
+			result: result.Err[int](errors.New("failure")),
+			output: `{"error":"failure"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			output, err := json.Marshal(testCase.result)
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if string(output) != testCase.output {
+				t.Logf("expected: %s, got: %s", testCase.output, output)
+				t.Fail()
+			}
+
+			var decoded result.T[int]
+			if err := json.Unmarshal(output, &decoded); err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if decoded.String() != testCase.result.String() {
+				t.Logf("expected: %s, got: %s", testCase.result, decoded)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		isValid bool
+	}{
+		{"Value", `{"value":[1]}`, true},
+		{"NullValue", `{"value":null}`, true},
+		{"Error", `{"error":"failure"}`, true},
+		{"Both", `{"value":[1],"error":"failure"}`, false},
+		{"Neither", `{}`, false},
+		{"NullError", `{"error":null}`, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var decoded result.T[[]int]
+			if err := json.Unmarshal([]byte(testCase.input), &decoded); (err == nil) != testCase.isValid {
+				t.Logf("unexpected error: %v", err)
+				t.Fail()
+			}
+		})
+	}
+
+	var decoded result.T[[]int]
+	output, _ := json.Marshal(result.Ok[[]int](nil))
+	if err := json.Unmarshal(output, &decoded); err != nil || !decoded.IsOk() {
+		t.Logf("expected Ok with nil slice to round trip, got: %s, %v", output, err)
+		t.Fail()
+	}
+}
+
+func TestErrNil(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Logf("expected panic for nil error")
+			t.Fail()
+		}
+	}()
+
+	result.Err[int](nil)
+}
+
+func TestPartition(t *testing.T) {
+	inputs := []string{"1", "two", "3", "four"}
+	results := make([]result.T[int], 0, len(inputs))
+	for _, input := range inputs {
+		results = append(results, result.From(strconv.Atoi(input)))
+	}
+
+	oks, errs := result.Partition(results)
+	if slices.Compare(oks, []int{1, 3}) != 0 || len(errs) != 2 {
+		t.Logf("unexpected partition: %v, %v", oks, errs)
+		t.Fail()
+	}
+
+	if _, err := result.Collect(results); err == nil {
+		t.Logf("expected error")
+		t.Fail()
+	}
+
+	if err := result.Errors(results[:1]); err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	doubled := result.Map(result.AndThen(result.Ok("21"), strconv.Atoi), func(n int) int {
+		return n * 2
+	})
+
+	if value := doubled.UnwrapOr(0); value != 42 {
+		t.Logf("expected: 42, got: %d", value)
+		t.Fail()
+	}
+
+	failed := result.AndThen(result.Ok("twenty one"), strconv.Atoi)
+	if optional := failed.Optional(); !failed.IsErr() || !optional.IsNull() {
+		t.Logf("expected error, got: %s", failed)
+		t.Fail()
+	}
+}
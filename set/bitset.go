@@ -0,0 +1,283 @@
+package set
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"math/bits"
+
+	"github.com/leshless/golibrary/optional"
+)
+
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+const (
+	wordSize = 64
+
+	// DefaultBitsetMax is the greatest item accepted by bitsets created without explicit limit, such bitset takes 2 MiB at most
+	DefaultBitsetMax = 1<<24 - 1
+)
+
+// Bitset is a set of small unsigned integers, using one bit per item up to the greatest one.
+// Items are limited by the limit given to NewBitsetCap or DefaultBitsetMax, since memory for all of the smaller ones
+// would have to be allocated, so Add reports greater items with an error. That's why Bitset is only Readable
+// and doesn't implement Interface and Mutable
+type Bitset[K Unsigned] struct {
+	words   []uint64
+	maxItem optional.T[K]
+}
+
+var _ encoding.BinaryMarshaler = (*Bitset[uint])(nil)
+var _ encoding.BinaryUnmarshaler = (*Bitset[uint])(nil)
+var _ json.Marshaler = (*Bitset[uint])(nil)
+var _ json.Unmarshaler = (*Bitset[uint])(nil)
+
+func NewBitset[K Unsigned]() *Bitset[K] {
+	return &Bitset[K]{}
+}
+
+// NewBitsetCap returns bitset accepting items up to limit inclusive
+func NewBitsetCap[K Unsigned](limit K) *Bitset[K] {
+	return &Bitset[K]{maxItem: optional.Some(limit)}
+}
+
+func BitsetFromSlice[K Unsigned](items []K) (*Bitset[K], error) {
+	b := &Bitset[K]{}
+	for _, item := range items {
+		if err := b.Add(item); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// limit returns the greatest item which may be added to the set
+func (b *Bitset[K]) limit() K {
+	if maxItem, ok := b.maxItem.Get(); ok {
+		return maxItem
+	}
+
+	return K(min(uint64(^K(0)), DefaultBitsetMax))
+}
+
+// Add returns error if item is greater than the bitset limit
+func (b *Bitset[K]) Add(item K) error {
+	if limit := b.limit(); item > limit {
+		return fmt.Errorf("bitset item %d exceeds max %d", item, limit)
+	}
+
+	word := int(item / wordSize)
+	if word >= len(b.words) {
+		b.words = append(b.words, make([]uint64, word-len(b.words)+1)...)
+	}
+
+	b.words[word] |= 1 << (item % wordSize)
+	return nil
+}
+
+func (b *Bitset[K]) Remove(item K) {
+	word := int(item / wordSize)
+	if word < len(b.words) {
+		b.words[word] &^= 1 << (item % wordSize)
+	}
+}
+
+func (b *Bitset[K]) Contains(item K) bool {
+	word := int(item / wordSize)
+	return word < len(b.words) && b.words[word]&(1<<(item%wordSize)) != 0
+}
+
+func (b *Bitset[K]) Len() int {
+	count := 0
+	for _, word := range b.words {
+		count += bits.OnesCount64(word)
+	}
+
+	return count
+}
+
+func (b *Bitset[K]) Slice() []K {
+	items := make([]K, 0, b.Len())
+	for item := range b.All() {
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// All iterates over items in ascending order
+func (b *Bitset[K]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for i, word := range b.words {
+			for word != 0 {
+				offset := bits.TrailingZeros64(word)
+				if !yield(K(i*wordSize + offset)) {
+					return
+				}
+
+				word &= word - 1
+			}
+		}
+	}
+}
+
+// NextSet returns the least item greater than or equal to the given one
+func (b *Bitset[K]) NextSet(from K) optional.T[K] {
+	i := int(from / wordSize)
+	if i >= len(b.words) {
+		return optional.None[K]()
+	}
+
+	word := b.words[i] >> (from % wordSize) << (from % wordSize)
+	for {
+		if word != 0 {
+			return optional.Some(K(i*wordSize + bits.TrailingZeros64(word)))
+		}
+
+		i++
+		if i == len(b.words) {
+			return optional.None[K]()
+		}
+
+		word = b.words[i]
+	}
+}
+
+// PrevSet returns the greatest item less than or equal to the given one
+func (b *Bitset[K]) PrevSet(from K) optional.T[K] {
+	i := int(from / wordSize)
+
+	var word uint64
+	if i >= len(b.words) {
+		i = len(b.words) - 1
+		if i < 0 {
+			return optional.None[K]()
+		}
+
+		word = b.words[i]
+	} else {
+		shift := wordSize - 1 - from%wordSize
+		word = b.words[i] << shift >> shift
+	}
+
+	for {
+		if word != 0 {
+			return optional.Some(K(i*wordSize + wordSize - 1 - bits.LeadingZeros64(word)))
+		}
+
+		i--
+		if i < 0 {
+			return optional.None[K]()
+		}
+
+		word = b.words[i]
+	}
+}
+
+func (b *Bitset[K]) Clone() *Bitset[K] {
+	words := make([]uint64, len(b.words))
+	copy(words, b.words)
+
+	return &Bitset[K]{words: words, maxItem: b.maxItem}
+}
+
+func (b *Bitset[K]) Union(other *Bitset[K]) *Bitset[K] {
+	long, short := b.words, other.words
+	if len(long) < len(short) {
+		long, short = short, long
+	}
+
+	words := make([]uint64, len(long))
+	copy(words, long)
+	for i, word := range short {
+		words[i] |= word
+	}
+
+	maxItem := b.maxItem
+	if other.limit() > b.limit() {
+		maxItem = other.maxItem
+	}
+
+	return &Bitset[K]{words: words, maxItem: maxItem}
+}
+
+func (b *Bitset[K]) Intersection(other *Bitset[K]) *Bitset[K] {
+	words := make([]uint64, min(len(b.words), len(other.words)))
+	for i := range words {
+		words[i] = b.words[i] & other.words[i]
+	}
+
+	return &Bitset[K]{words: words, maxItem: b.maxItem}
+}
+
+func (b *Bitset[K]) Diff(other *Bitset[K]) *Bitset[K] {
+	words := make([]uint64, len(b.words))
+	copy(words, b.words)
+	for i := range min(len(words), len(other.words)) {
+		words[i] &^= other.words[i]
+	}
+
+	return &Bitset[K]{words: words, maxItem: b.maxItem}
+}
+
+// MarshalBinary encodes words in little endian order, trailing empty words are omitted
+func (b *Bitset[K]) MarshalBinary() ([]byte, error) {
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n--
+	}
+
+	data := make([]byte, 0, n*8)
+	for _, word := range b.words[:n] {
+		data = binary.LittleEndian.AppendUint64(data, word)
+	}
+
+	return data, nil
+}
+
+func (b *Bitset[K]) UnmarshalBinary(data []byte) error {
+	if len(data)%8 != 0 {
+		return fmt.Errorf("malformed bitset length: %d", len(data))
+	}
+
+	limit := uint64(b.limit())
+
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[i*8:])
+		if words[i] == 0 || uint64(i) < limit/wordSize {
+			continue
+		}
+
+		if greatest := uint64(i)*wordSize + wordSize - 1 - uint64(bits.LeadingZeros64(words[i])); greatest > limit {
+			return fmt.Errorf("bitset item %d exceeds max %d", greatest, limit)
+		}
+	}
+
+	b.words = words
+	return nil
+}
+
+// MarshalJSON encodes binary form as base64 string
+func (b *Bitset[K]) MarshalJSON() ([]byte, error) {
+	data, err := b.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(data)
+}
+
+func (b *Bitset[K]) UnmarshalJSON(data []byte) error {
+	var raw []byte
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return b.UnmarshalBinary(raw)
+}
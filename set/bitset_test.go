@@ -0,0 +1,210 @@
+package set_test
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+	"github.com/leshless/golibrary/set"
+	"github.com/leshless/golibrary/sets"
+)
+
+func mustBitset[K set.Unsigned](t *testing.T, items []K) *set.Bitset[K] {
+	t.Helper()
+
+	b, err := set.BitsetFromSlice(items)
+	if err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	return b
+}
+
+func TestBitset(t *testing.T) {
+	b := mustBitset(t, []uint{3, 64, 1, 200, 64})
+	b.Remove(1)
+	b.Remove(1000)
+
+	if result := b.Slice(); slices.Compare(result, []uint{3, 64, 200}) != 0 || b.Len() != 3 {
+		t.Logf("unexpected items: %v", result)
+		t.Fail()
+	}
+
+	testCases := []struct {
+		name   string
+		result optional.T[uint]
+		expect optional.T[uint]
+	}{
+		{"NextExact", b.NextSet(64), optional.Some[uint](64)},
+		{"NextAcrossWords", b.NextSet(65), optional.Some[uint](200)},
+		{"NextMissing", b.NextSet(201), optional.None[uint]()},
+		{"PrevExact", b.PrevSet(3), optional.Some[uint](3)},
+		{"PrevAcrossWords", b.PrevSet(199), optional.Some[uint](64)},
+		{"PrevBeyond", b.PrevSet(5000), optional.Some[uint](200)},
+		{"PrevMissing", b.PrevSet(2), optional.None[uint]()},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.result != testCase.expect {
+				t.Logf("expected: %v, got: %v", testCase.expect, testCase.result)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestBitsetOperations(t *testing.T) {
+	a := mustBitset(t, []uint16{1, 2, 100, 300})
+	b := mustBitset(t, []uint16{2, 100, 5})
+
+	testCases := []struct {
+		name   string
+		result *set.Bitset[uint16]
+		expect []uint16
+	}{
+		{"Union", a.Union(b), []uint16{1, 2, 5, 100, 300}},
+		{"Intersection", a.Intersection(b), []uint16{2, 100}},
+		{"Diff", a.Diff(b), []uint16{1, 300}},
+		{"DiffReverse", b.Diff(a), []uint16{5}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := testCase.result.Slice(); slices.Compare(result, testCase.expect) != 0 {
+				t.Logf("expected: %v, got: %v", testCase.expect, result)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestBitsetJSON(t *testing.T) {
+	b := mustBitset(t, []uint{0, 7, 130})
+	b.Add(1000)
+	b.Remove(1000)
+
+	output, err := json.Marshal(b)
+	if err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	decoded := set.NewBitset[uint]()
+	if err := json.Unmarshal(output, decoded); err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	// 3 words are 24 bytes, which take 32 base64 characters and quotes
+	if slices.Compare(decoded.Slice(), b.Slice()) != 0 || len(output) != 34 {
+		t.Logf("unexpected round trip: %s, %v", output, decoded.Slice())
+		t.Fail()
+	}
+}
+
+func TestBitsetMax(t *testing.T) {
+	testCases := []struct {
+		name  string
+		b     *set.Bitset[uint64]
+		valid uint64
+		large uint64
+	}{
+		{"Default", set.NewBitset[uint64](), set.DefaultBitsetMax, 1 << 62},
+		{"Cap", set.NewBitsetCap[uint64](100), 100, 101},
+		{"Clone", set.NewBitsetCap[uint64](100).Clone(), 100, 101},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if err := testCase.b.Add(testCase.valid); err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.Fail()
+			}
+
+			if err := testCase.b.Add(testCase.large); err == nil || testCase.b.Contains(testCase.large) {
+				t.Logf("expected error for item exceeding max")
+				t.Fail()
+			}
+		})
+	}
+
+	t.Run("SmallType", func(t *testing.T) {
+		b := set.NewBitset[uint8]()
+		if err := b.Add(255); err != nil || !b.Contains(255) || b.Len() != 1 {
+			t.Logf("unexpected items: %v, %v", b.Slice(), err)
+			t.Fail()
+		}
+	})
+
+	t.Run("FromSlice", func(t *testing.T) {
+		if _, err := set.BitsetFromSlice([]uint{1, 1 << 40}); err == nil {
+			t.Logf("expected error for item exceeding max")
+			t.Fail()
+		}
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		data, _ := mustBitset(t, []uint{1, 200}).MarshalBinary()
+
+		if err := set.NewBitsetCap[uint](200).UnmarshalBinary(data); err != nil {
+			t.Logf("unexpected error: %s", err)
+			t.Fail()
+		}
+
+		if err := set.NewBitsetCap[uint](199).UnmarshalBinary(data); err == nil {
+			t.Logf("expected error for item exceeding max")
+			t.Fail()
+		}
+	})
+
+	t.Run("NotMutable", func(t *testing.T) {
+		if _, ok := any(set.NewBitset[uint]()).(set.Interface[uint]); ok {
+			t.Logf("bitset must not implement set.Interface, since its Add may fail")
+			t.Fail()
+		}
+	})
+}
+
+func BenchmarkBitsetAdd(b *testing.B) {
+	s := set.NewBitset[uint32]()
+	for i := range b.N {
+		s.Add(uint32(i % benchmarkDomain))
+	}
+}
+
+func BenchmarkMapSetAdd(b *testing.B) {
+	s := set.New[uint32]()
+	for i := range b.N {
+		s.Add(uint32(i % benchmarkDomain))
+	}
+}
+
+func BenchmarkBitsetUnion(b *testing.B) {
+	x, y := set.NewBitset[uint32](), set.NewBitset[uint32]()
+	for range benchmarkDomain / 2 {
+		x.Add(rand.Uint32N(benchmarkDomain))
+		y.Add(rand.Uint32N(benchmarkDomain))
+	}
+
+	b.ResetTimer()
+	for range b.N {
+		x.Union(y)
+	}
+}
+
+func BenchmarkMapSetUnion(b *testing.B) {
+	x, y := set.New[uint32](), set.New[uint32]()
+	for range benchmarkDomain / 2 {
+		x.Add(rand.Uint32N(benchmarkDomain))
+		y.Add(rand.Uint32N(benchmarkDomain))
+	}
+
+	b.ResetTimer()
+	for range b.N {
+		sets.Union(x, y)
+	}
+}
@@ -0,0 +1,120 @@
+package set
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Bloom is a probabilistic set, which may report false positives, but never reports false negatives
+type Bloom[K any] struct {
+	words  []uint64
+	bits   uint64
+	hashes uint64
+	hash   Hasher[K]
+}
+
+var _ encoding.BinaryMarshaler = (*Bloom[string])(nil)
+var _ encoding.BinaryUnmarshaler = (*Bloom[string])(nil)
+
+const (
+	bloomHeaderSize = 16
+)
+
+// NewBloom creates filter sized for the expected number of items to keep false positive rate below the given one
+func NewBloom[K any](expected int, falsePositiveRate float64, hash Hasher[K]) *Bloom[K] {
+	n := float64(max(expected, 1))
+	p := min(max(falsePositiveRate, math.SmallestNonzeroFloat64), 0.5)
+
+	bits := uint64(math.Ceil(-n * math.Log(p) / (math.Ln2 * math.Ln2)))
+	hashes := uint64(max(math.Round(float64(bits)/n*math.Ln2), 1))
+
+	return &Bloom[K]{
+		words:  make([]uint64, (bits+wordSize-1)/wordSize),
+		bits:   bits,
+		hashes: hashes,
+		hash:   hash,
+	}
+}
+
+func (b *Bloom[K]) Add(key K) {
+	h1, h2 := b.hashPair(key)
+	for i := range b.hashes {
+		bit := (h1 + i*h2) % b.bits
+		b.words[bit/wordSize] |= 1 << (bit % wordSize)
+	}
+}
+
+// Contains reports whether key may be in the set, false means the key was definitely never added
+func (b *Bloom[K]) Contains(key K) bool {
+	h1, h2 := b.hashPair(key)
+	for i := range b.hashes {
+		bit := (h1 + i*h2) % b.bits
+		if b.words[bit/wordSize]&(1<<(bit%wordSize)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Merge adds all keys of other filter, which must have the same size and hash function
+func (b *Bloom[K]) Merge(other *Bloom[K]) error {
+	if b.bits != other.bits || b.hashes != other.hashes {
+		return errors.New("merging bloom filters of different sizes")
+	}
+
+	for i, word := range other.words {
+		b.words[i] |= word
+	}
+
+	return nil
+}
+
+// MarshalBinary encodes filter size followed by its bits, hash function must be provided on decoding
+func (b *Bloom[K]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, bloomHeaderSize+len(b.words)*8)
+	data = binary.LittleEndian.AppendUint64(data, b.bits)
+	data = binary.LittleEndian.AppendUint64(data, b.hashes)
+	for _, word := range b.words {
+		data = binary.LittleEndian.AppendUint64(data, word)
+	}
+
+	return data, nil
+}
+
+// UnmarshalBinary restores filter size and bits keeping its hash function, so it should be called
+// on a filter created by NewBloom with the same hash function as the encoded one
+func (b *Bloom[K]) UnmarshalBinary(data []byte) error {
+	if b.hash == nil {
+		return errors.New("bloom filter hash function is not set")
+	}
+
+	if len(data) < bloomHeaderSize {
+		return fmt.Errorf("malformed bloom filter length: %d", len(data))
+	}
+
+	bits := binary.LittleEndian.Uint64(data)
+	hashes := binary.LittleEndian.Uint64(data[8:])
+	data = data[bloomHeaderSize:]
+
+	if bits == 0 || hashes == 0 || uint64(len(data)) != (bits+wordSize-1)/wordSize*8 {
+		return fmt.Errorf("malformed bloom filter of %d bits and %d hashes", bits, hashes)
+	}
+
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+
+	b.words, b.bits, b.hashes = words, bits, hashes
+	return nil
+}
+
+// hashPair derives two hashes for double hashing, the second one is odd to cover all bits
+func (b *Bloom[K]) hashPair(key K) (uint64, uint64) {
+	h := mix(b.hash(key))
+	return h, mix(h) | 1
+}
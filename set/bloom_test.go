@@ -0,0 +1,94 @@
+package set_test
+
+import (
+	"encoding/binary"
+	"strconv"
+	"testing"
+
+	"github.com/leshless/golibrary/set"
+)
+
+func TestBloomAccuracy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected int
+		rate     float64
+	}{
+		{"OnePercent", 10000, 0.01},
+		{"TenthOfPercent", 10000, 0.001},
+		{"Small", 100, 0.05},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			bloom := set.NewBloom(testCase.expected, testCase.rate, set.HashString)
+			for i := range testCase.expected {
+				bloom.Add("key" + strconv.Itoa(i))
+			}
+
+			for i := range testCase.expected {
+				if !bloom.Contains("key" + strconv.Itoa(i)) {
+					t.Logf("false negative for key%d", i)
+					t.FailNow()
+				}
+			}
+
+			const probes = 100000
+
+			falsePositives := 0
+			for i := range probes {
+				if bloom.Contains("other" + strconv.Itoa(i)) {
+					falsePositives++
+				}
+			}
+
+			if rate := float64(falsePositives) / probes; rate > testCase.rate*1.5 {
+				t.Logf("false positive rate %f exceeds %f", rate, testCase.rate)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestBloomMergeAndSerialize(t *testing.T) {
+	a := set.NewBloom(1000, 0.01, set.HashBytes)
+	b := set.NewBloom(1000, 0.01, set.HashBytes)
+
+	for i := range uint64(1000) {
+		key := binary.LittleEndian.AppendUint64(nil, i)
+		if i%2 == 0 {
+			a.Add(key)
+		} else {
+			b.Add(key)
+		}
+	}
+
+	if err := a.Merge(b); err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	data, err := a.MarshalBinary()
+	if err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	decoded := set.NewBloom(1, 0.5, set.HashBytes)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	for i := range uint64(1000) {
+		if !decoded.Contains(binary.LittleEndian.AppendUint64(nil, i)) {
+			t.Logf("false negative for %d", i)
+			t.FailNow()
+		}
+	}
+
+	if err := a.Merge(set.NewBloom(10, 0.01, set.HashBytes)); err == nil {
+		t.Logf("expected error merging different sizes")
+		t.Fail()
+	}
+}
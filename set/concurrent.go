@@ -0,0 +1,135 @@
+package set
+
+import (
+	"hash/maphash"
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// Concurrent is a set safe for concurrent use, items are distributed across independently locked shards
+type Concurrent[K comparable] struct {
+	seed   maphash.Seed
+	shards []concurrentShard[K]
+	len    atomic.Int64
+}
+
+type concurrentShard[K comparable] struct {
+	mu    sync.RWMutex
+	items T[K]
+}
+
+func NewConcurrent[K comparable](options ...ConcurrentOption) *Concurrent[K] {
+	config := concurrentDefaultConfig
+	for _, option := range options {
+		option(&config)
+	}
+
+	shards := make([]concurrentShard[K], config.shards)
+	for i := range shards {
+		shards[i].items = New[K]()
+	}
+
+	return &Concurrent[K]{
+		seed:   maphash.MakeSeed(),
+		shards: shards,
+	}
+}
+
+func (s *Concurrent[K]) Add(item K) {
+	s.AddIfAbsent(item)
+}
+
+func (s *Concurrent[K]) Remove(item K) {
+	s.RemoveIfPresent(item)
+}
+
+// AddIfAbsent atomically adds item and reports whether it wasn't in the set before
+func (s *Concurrent[K]) AddIfAbsent(item K) bool {
+	shard := s.shard(item)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	if shard.items.Contains(item) {
+		return false
+	}
+
+	shard.items.Add(item)
+	s.len.Add(1)
+
+	return true
+}
+
+// RemoveIfPresent atomically removes item and reports whether it was in the set before
+func (s *Concurrent[K]) RemoveIfPresent(item K) bool {
+	shard := s.shard(item)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	if !shard.items.Contains(item) {
+		return false
+	}
+
+	shard.items.Remove(item)
+	s.len.Add(-1)
+
+	return true
+}
+
+func (s *Concurrent[K]) Contains(item K) bool {
+	shard := s.shard(item)
+
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+
+	return shard.items.Contains(item)
+}
+
+// Len doesn't lock shards at all, so it may be slightly outdated under concurrent modifications
+func (s *Concurrent[K]) Len() int {
+	return int(s.len.Load())
+}
+
+// Snapshot returns consistent copy of the set, all shards are locked for the time of copying
+func (s *Concurrent[K]) Snapshot() T[K] {
+	for i := range s.shards {
+		s.shards[i].mu.RLock()
+	}
+	defer func() {
+		for i := range s.shards {
+			s.shards[i].mu.RUnlock()
+		}
+	}()
+
+	snapshot := make(T[K], s.len.Load())
+	for i := range s.shards {
+		for item := range s.shards[i].items {
+			snapshot.Add(item)
+		}
+	}
+
+	return snapshot
+}
+
+func (s *Concurrent[K]) Slice() []K {
+	return s.Snapshot().Slice()
+}
+
+// All iterates over the snapshot taken at the moment of the call
+func (s *Concurrent[K]) All() iter.Seq[K] {
+	snapshot := s.Snapshot()
+
+	return func(yield func(K) bool) {
+		for item := range snapshot {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Concurrent[K]) shard(item K) *concurrentShard[K] {
+	return &s.shards[maphash.Comparable(s.seed, item)%uint64(len(s.shards))]
+}
@@ -0,0 +1,20 @@
+package set
+
+type concurrentConfig struct {
+	shards int
+}
+
+var concurrentDefaultConfig = concurrentConfig{
+	shards: 32,
+}
+
+type ConcurrentOption func(config *concurrentConfig)
+
+// WithShards sets number of independently locked shards, non-positive values are ignored
+func WithShards(shards int) ConcurrentOption {
+	return func(config *concurrentConfig) {
+		if shards > 0 {
+			config.shards = shards
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package set_test
+
+import (
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/leshless/golibrary/set"
+)
+
+func TestConcurrent(t *testing.T) {
+	s := set.NewConcurrent[int](set.WithShards(4))
+
+	var (
+		wg    sync.WaitGroup
+		added [8]int
+	)
+
+	for worker := range len(added) {
+		wg.Go(func() {
+			for item := range 1000 {
+				if s.AddIfAbsent(item) {
+					added[worker]++
+				}
+			}
+		})
+	}
+
+	wg.Wait()
+
+	total := 0
+	for _, count := range added {
+		total += count
+	}
+
+	if total != 1000 || s.Len() != 1000 || len(s.Snapshot()) != 1000 {
+		t.Logf("expected 1000 items, added: %d, len: %d, snapshot: %d", total, s.Len(), len(s.Snapshot()))
+		t.Fail()
+	}
+
+	if !s.RemoveIfPresent(42) || s.RemoveIfPresent(42) || s.Contains(42) || s.Len() != 999 {
+		t.Logf("unexpected removal result")
+		t.Fail()
+	}
+}
+
+type mutexSet[K comparable] struct {
+	mu    sync.RWMutex
+	items set.T[K]
+}
+
+func (s *mutexSet[K]) Add(item K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items.Add(item)
+}
+
+func (s *mutexSet[K]) Contains(item K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.items.Contains(item)
+}
+
+const benchmarkDomain = 1 << 16
+
+func BenchmarkConcurrent(b *testing.B) {
+	s := set.NewConcurrent[int]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		random := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+		for pb.Next() {
+			item := random.IntN(benchmarkDomain)
+			if item%4 == 0 {
+				s.Add(item)
+			} else {
+				s.Contains(item)
+			}
+		}
+	})
+}
+
+func BenchmarkMutexSet(b *testing.B) {
+	s := &mutexSet[int]{items: set.New[int]()}
+
+	b.RunParallel(func(pb *testing.PB) {
+		random := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+		for pb.Next() {
+			item := random.IntN(benchmarkDomain)
+			if item%4 == 0 {
+				s.Add(item)
+			} else {
+				s.Contains(item)
+			}
+		}
+	})
+}
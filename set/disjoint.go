@@ -0,0 +1,125 @@
+package set
+
+import "github.com/leshless/golibrary/optional"
+
+// Disjoint is a union-find structure, which splits items into non-overlapping components.
+// Path compression and union by rank make all operations effectively O(1)
+type Disjoint[K comparable] struct {
+	indexes    map[K]int
+	items      []K
+	parents    []int
+	ranks      []uint8
+	components int
+}
+
+func NewDisjoint[K comparable]() *Disjoint[K] {
+	return &Disjoint[K]{
+		indexes: make(map[K]int),
+	}
+}
+
+// Add adds item as a singleton component, existing items are left untouched
+func (d *Disjoint[K]) Add(item K) {
+	d.index(item)
+}
+
+func (d *Disjoint[K]) Contains(item K) bool {
+	_, exists := d.indexes[item]
+	return exists
+}
+
+// Len returns number of items
+func (d *Disjoint[K]) Len() int {
+	return len(d.items)
+}
+
+func (d *Disjoint[K]) ComponentCount() int {
+	return d.components
+}
+
+// Find returns representative item of the component, None is returned for unknown items
+func (d *Disjoint[K]) Find(item K) optional.T[K] {
+	i, exists := d.indexes[item]
+	if !exists {
+		return optional.None[K]()
+	}
+
+	return optional.Some(d.items[d.root(i)])
+}
+
+// Union merges components of both items adding missing ones first, returns false if they were already connected
+func (d *Disjoint[K]) Union(a, b K) bool {
+	x, y := d.root(d.index(a)), d.root(d.index(b))
+	if x == y {
+		return false
+	}
+
+	if d.ranks[x] < d.ranks[y] {
+		x, y = y, x
+	}
+
+	d.parents[y] = x
+	if d.ranks[x] == d.ranks[y] {
+		d.ranks[x]++
+	}
+
+	d.components--
+
+	return true
+}
+
+func (d *Disjoint[K]) Connected(a, b K) bool {
+	x, xExists := d.indexes[a]
+	y, yExists := d.indexes[b]
+
+	return xExists && yExists && d.root(x) == d.root(y)
+}
+
+// Components returns every component as a separate set
+func (d *Disjoint[K]) Components() []T[K] {
+	components := make([]T[K], 0, d.components)
+	byRoot := make(map[int]int, d.components)
+
+	for i, item := range d.items {
+		root := d.root(i)
+
+		j, exists := byRoot[root]
+		if !exists {
+			j = len(components)
+			byRoot[root] = j
+			components = append(components, New[K]())
+		}
+
+		components[j].Add(item)
+	}
+
+	return components
+}
+
+func (d *Disjoint[K]) index(item K) int {
+	if i, exists := d.indexes[item]; exists {
+		return i
+	}
+
+	i := len(d.items)
+	d.indexes[item] = i
+	d.items = append(d.items, item)
+	d.parents = append(d.parents, i)
+	d.ranks = append(d.ranks, 0)
+	d.components++
+
+	return i
+}
+
+func (d *Disjoint[K]) root(i int) int {
+	root := i
+	for d.parents[root] != root {
+		root = d.parents[root]
+	}
+
+	for d.parents[i] != root {
+		d.parents[i], i = root, d.parents[i]
+	}
+
+	return root
+}
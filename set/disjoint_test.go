@@ -0,0 +1,62 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+	"github.com/leshless/golibrary/set"
+)
+
+func TestDisjoint(t *testing.T) {
+	d := set.NewDisjoint[string]()
+	d.Add("solo")
+	d.Union("a", "b")
+	d.Union("c", "d")
+	d.Union("b", "c")
+	d.Union("x", "y")
+
+	if d.Union("a", "d") {
+		t.Logf("expected a and d to be connected already")
+		t.Fail()
+	}
+
+	testCases := []struct {
+		a, b      string
+		connected bool
+	}{
+		{"a", "d", true},
+		{"x", "y", true},
+		{"a", "x", false},
+		{"solo", "a", false},
+		{"unknown", "unknown", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.a+testCase.b, func(t *testing.T) {
+			if d.Connected(testCase.a, testCase.b) != testCase.connected {
+				t.Logf("expected connected: %t", testCase.connected)
+				t.Fail()
+			}
+		})
+	}
+
+	if d.ComponentCount() != 3 || d.Len() != 7 {
+		t.Logf("unexpected counts: %d components of %d items", d.ComponentCount(), d.Len())
+		t.Fail()
+	}
+
+	if d.Find("a") != d.Find("d") || d.Find("unknown") != optional.None[string]() {
+		t.Logf("unexpected representatives")
+		t.Fail()
+	}
+
+	sizes := make(map[int]int)
+	for _, component := range d.Components() {
+		sizes[component.Len()]++
+	}
+
+	if sizes[1] != 1 || sizes[2] != 1 || sizes[4] != 1 {
+		t.Logf("unexpected component sizes: %v", sizes)
+		t.Fail()
+	}
+}
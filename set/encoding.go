@@ -0,0 +1,291 @@
+package set
+
+import (
+	"bytes"
+	"cmp"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/leshless/golibrary/internal/textcodec"
+)
+
+var _ json.Marshaler = T[struct{}]{}
+var _ json.Unmarshaler = (*T[struct{}])(nil)
+var _ encoding.TextMarshaler = T[struct{}]{}
+var _ encoding.TextUnmarshaler = (*T[struct{}])(nil)
+var _ sql.Scanner = (*T[struct{}])(nil)
+var _ driver.Valuer = T[struct{}]{}
+
+// Strict is a drop-in wrapper for T which rejects duplicate items when decoding JSON
+type Strict[K comparable] struct {
+	T[K]
+}
+
+var _ json.Unmarshaler = (*Strict[struct{}])(nil)
+
+// MarshalJSON encodes set as an array. Items of ordered kinds are sorted naturally,
+// others are sorted by their encoded form, so the output is always deterministic
+func (s T[K]) MarshalJSON() ([]byte, error) {
+	items, err := s.encodeSorted(func(item K) ([]byte, error) {
+		return json.Marshal(item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(toRawMessages(items))
+}
+
+func (s *T[K]) UnmarshalJSON(data []byte) error {
+	return s.unmarshalJSON(data, false)
+}
+
+func (s *Strict[K]) UnmarshalJSON(data []byte) error {
+	return s.unmarshalJSON(data, true)
+}
+
+func (s *T[K]) unmarshalJSON(data []byte, rejectDuplicates bool) error {
+	var items []K
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	result := make(T[K], len(items))
+	for _, item := range items {
+		if rejectDuplicates && result.Contains(item) {
+			return fmt.Errorf("duplicate set item: %v", item)
+		}
+
+		result.Add(item)
+	}
+
+	*s = result
+	return nil
+}
+
+// MarshalText encodes set as Postgres array literal, e.g. {a,"b c"}
+func (s T[K]) MarshalText() ([]byte, error) {
+	items, err := s.encodeSorted(func(item K) ([]byte, error) {
+		return textcodec.Marshal(&item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, item := range items {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+
+		writeArrayElement(&buf, string(item))
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func (s *T[K]) UnmarshalText(text []byte) error {
+	elements, err := parseArrayLiteral(string(text))
+	if err != nil {
+		return err
+	}
+
+	result := make(T[K], len(elements))
+	for _, element := range elements {
+		var item K
+		if err := textcodec.Unmarshal([]byte(element), &item); err != nil {
+			return fmt.Errorf("decoding array element %q: %w", element, err)
+		}
+
+		result.Add(item)
+	}
+
+	*s = result
+	return nil
+}
+
+// Scan implements sql.Scanner for Postgres array columns, SQL NULL is scanned as an empty set
+func (s *T[K]) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*s = New[K]()
+		return nil
+	case string:
+		return s.UnmarshalText([]byte(src))
+	case []byte:
+		return s.UnmarshalText(src)
+	default:
+		return fmt.Errorf("unsupported scan source type: %T", src)
+	}
+}
+
+// Value implements driver.Valuer, encoding set as Postgres array literal
+func (s T[K]) Value() (driver.Value, error) {
+	text, err := s.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// encodeSorted encodes every item and sorts results deterministically
+func (s T[K]) encodeSorted(encode func(item K) ([]byte, error)) ([][]byte, error) {
+	items := s.Slice()
+
+	isOrdered := isOrderedKind(reflect.TypeFor[K]().Kind())
+	if isOrdered {
+		slices.SortFunc(items, compareOrdered)
+	}
+
+	encoded := make([][]byte, 0, len(items))
+	for _, item := range items {
+		data, err := encode(item)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded = append(encoded, data)
+	}
+
+	if !isOrdered {
+		slices.SortFunc(encoded, bytes.Compare)
+	}
+
+	return encoded, nil
+}
+
+func isOrderedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	default:
+		return false
+	}
+}
+
+func compareOrdered[K any](a, b K) int {
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(x.Int(), y.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(x.Uint(), y.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(x.Float(), y.Float())
+	default:
+		return cmp.Compare(x.String(), y.String())
+	}
+}
+
+func toRawMessages(items [][]byte) []json.RawMessage {
+	raws := make([]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		raws = append(raws, item)
+	}
+
+	return raws
+}
+
+func writeArrayElement(buf *bytes.Buffer, element string) {
+	needsQuotes := element == "" || strings.EqualFold(element, "NULL") || strings.ContainsAny(element, "{}\",\\ \t\n\r\v\f")
+	if !needsQuotes {
+		buf.WriteString(element)
+		return
+	}
+
+	buf.WriteByte('"')
+	for _, r := range element {
+		if r == '"' || r == '\\' {
+			buf.WriteByte('\\')
+		}
+		buf.WriteRune(r)
+	}
+	buf.WriteByte('"')
+}
+
+// parseArrayLiteral parses one-dimensional Postgres array literal into its elements
+func parseArrayLiteral(literal string) ([]string, error) {
+	literal = strings.TrimSpace(literal)
+	if len(literal) < 2 || literal[0] != '{' || literal[len(literal)-1] != '}' {
+		return nil, fmt.Errorf("malformed array literal: %q", literal)
+	}
+
+	inner := literal[1 : len(literal)-1]
+	elements := make([]string, 0)
+	if strings.TrimSpace(inner) == "" {
+		return elements, nil
+	}
+
+	for i := 0; ; {
+		for i < len(inner) && isArraySpace(inner[i]) {
+			i++
+		}
+
+		var element strings.Builder
+
+		if i < len(inner) && inner[i] == '"' {
+			i++
+			for ; i < len(inner) && inner[i] != '"'; i++ {
+				if inner[i] == '\\' {
+					i++
+					if i == len(inner) {
+						break
+					}
+				}
+				element.WriteByte(inner[i])
+			}
+
+			if i == len(inner) {
+				return nil, errors.New("unterminated quoted array element")
+			}
+			i++
+
+			for i < len(inner) && isArraySpace(inner[i]) {
+				i++
+			}
+		} else {
+			start := i
+			for i < len(inner) && inner[i] != ',' {
+				if inner[i] == '{' || inner[i] == '"' {
+					return nil, fmt.Errorf("unsupported array literal: %q", literal)
+				}
+				i++
+			}
+
+			unquoted := strings.TrimSpace(inner[start:i])
+			if strings.EqualFold(unquoted, "NULL") {
+				return nil, errors.New("null array elements can't be stored in a set")
+			}
+
+			element.WriteString(unquoted)
+		}
+
+		elements = append(elements, element.String())
+
+		if i == len(inner) {
+			return elements, nil
+		}
+
+		if inner[i] != ',' {
+			return nil, fmt.Errorf("malformed array literal: %q", literal)
+		}
+		i++
+	}
+}
+
+func isArraySpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
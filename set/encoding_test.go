@@ -0,0 +1,125 @@
+package set_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/leshless/golibrary/set"
+)
+
+func TestJSON(t *testing.T) {
+	testCases := []struct {
+		name   string
+		set    set.T[int]
+		output string
+	}{
+		{"Empty", set.New[int](), `[]`},
+		{"Sorted", set.FromSlice([]int{10, 9, -1, 100}), `[-1,9,10,100]`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			output, err := json.Marshal(testCase.set)
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if string(output) != testCase.output {
+				t.Logf("expected: %s, got: %s", testCase.output, output)
+				t.Fail()
+			}
+
+			var decoded set.T[int]
+			if err := json.Unmarshal(output, &decoded); err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if decoded.Len() != testCase.set.Len() {
+				t.Logf("expected: %v, got: %v", testCase.set.Slice(), decoded.Slice())
+				t.Fail()
+			}
+		})
+	}
+
+	t.Run("NotOrdered", func(t *testing.T) {
+		type point struct{ X, Y int }
+
+		output, err := json.Marshal(set.FromSlice([]point{{2, 1}, {1, 2}}))
+		if err != nil || string(output) != `[{"X":1,"Y":2},{"X":2,"Y":1}]` {
+			t.Logf("unexpected output: %s, %v", output, err)
+			t.Fail()
+		}
+	})
+}
+
+func TestStrict(t *testing.T) {
+	var lenient set.T[string]
+	if err := json.Unmarshal([]byte(`["a","a"]`), &lenient); err != nil || lenient.Len() != 1 {
+		t.Logf("unexpected result: %v, %v", lenient.Slice(), err)
+		t.Fail()
+	}
+
+	var strict set.Strict[string]
+	if err := json.Unmarshal([]byte(`["a","a"]`), &strict); err == nil {
+		t.Logf("expected duplicate error")
+		t.Fail()
+	}
+}
+
+func TestArrayLiteral(t *testing.T) {
+	testCases := []struct {
+		name    string
+		items   []string
+		literal string
+	}{
+		{"Empty", []string{}, `{}`},
+		{"Plain", []string{"b", "a"}, `{a,b}`},
+		{"Quoted", []string{"", "a b", `say "hi"`, `back\slash`, "null", "{x}"}, `{"","a b","back\\slash","null","say \"hi\"","{x}"}`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			value, err := set.FromSlice(testCase.items).Value()
+			if err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			if value != testCase.literal {
+				t.Logf("expected: %s, got: %s", testCase.literal, value)
+				t.Fail()
+			}
+
+			var scanned set.T[string]
+			if err := scanned.Scan([]byte(value.(string))); err != nil {
+				t.Logf("unexpected error: %s", err)
+				t.FailNow()
+			}
+
+			expected := slices.Sorted(slices.Values(testCase.items))
+			if result := slices.Sorted(scanned.All()); slices.Compare(result, expected) != 0 {
+				t.Logf("expected: %q, got: %q (literal %s)", expected, result, value)
+				t.Fail()
+			}
+		})
+	}
+
+	t.Run("Ints", func(t *testing.T) {
+		var scanned set.T[int]
+		if err := scanned.Scan(" { 3, 1 ,2 } "); err != nil || !scanned.Contains(3) || scanned.Len() != 3 {
+			t.Logf("unexpected result: %v, %v", scanned.Slice(), err)
+			t.Fail()
+		}
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		var scanned set.T[int]
+		if err := scanned.Scan("{1,NULL}"); err == nil {
+			t.Logf("expected error for null element")
+			t.Fail()
+		}
+	})
+}
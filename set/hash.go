@@ -0,0 +1,45 @@
+package set
+
+import (
+	"hash/fnv"
+	"hash/maphash"
+)
+
+// Hasher maps keys to 64-bit hashes for probabilistic sets. Hashes don't need to be well distributed,
+// since they are mixed before use, but they must be stable across processes for serialized sets to stay valid
+type Hasher[K any] func(key K) uint64
+
+// HashBytes is stable FNV-1a hash of a byte slice
+func HashBytes(key []byte) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write(key)
+
+	return h.Sum64()
+}
+
+// HashString is stable FNV-1a hash of a string
+func HashString(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+
+	return h.Sum64()
+}
+
+// HashComparable returns hasher for any comparable key, which depends on the seed.
+// The seed can't be persisted, so it's not suitable for sets which are serialized and loaded by other processes
+func HashComparable[K comparable](seed maphash.Seed) Hasher[K] {
+	return func(key K) uint64 {
+		return maphash.Comparable(seed, key)
+	}
+}
+
+// mix is splitmix64 finalizer, it makes any hash well distributed
+func mix(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+
+	return h
+}
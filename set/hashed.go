@@ -0,0 +1,220 @@
+package set
+
+import (
+	"bytes"
+	"hash/maphash"
+	"iter"
+	"math"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// Hashed is a set of any items, including non-comparable ones, built on user-supplied hash and equality.
+// Items considered equal must have equal hashes
+type Hashed[K any] struct {
+	buckets map[uint64][]K
+	len     int
+	hash    Hasher[K]
+	equal   func(a, b K) bool
+}
+
+func NewHashed[K any](hash Hasher[K], equal func(a, b K) bool) *Hashed[K] {
+	return &Hashed[K]{
+		buckets: make(map[uint64][]K),
+		hash:    hash,
+		equal:   equal,
+	}
+}
+
+// NewHashedBytes creates set of byte slices compared by content
+func NewHashedBytes() *Hashed[[]byte] {
+	return NewHashed(HashBytes, bytes.Equal)
+}
+
+// NewHashedFold creates set of case-insensitive strings, which are equal under strings.EqualFold
+func NewHashedFold() *Hashed[string] {
+	return NewHashed(HashFold, strings.EqualFold)
+}
+
+// NewHashedReflect creates set of any items compared by reflect.DeepEqual
+func NewHashedReflect[K any]() *Hashed[K] {
+	return NewHashed(HashReflect[K], func(a, b K) bool {
+		return reflect.DeepEqual(a, b)
+	})
+}
+
+func (s *Hashed[K]) Add(item K) {
+	h := s.hash(item)
+	for _, existing := range s.buckets[h] {
+		if s.equal(existing, item) {
+			return
+		}
+	}
+
+	s.buckets[h] = append(s.buckets[h], item)
+	s.len++
+}
+
+func (s *Hashed[K]) Remove(item K) {
+	h := s.hash(item)
+
+	bucket := s.buckets[h]
+	for i, existing := range bucket {
+		if !s.equal(existing, item) {
+			continue
+		}
+
+		if len(bucket) == 1 {
+			delete(s.buckets, h)
+		} else {
+			bucket[i] = bucket[len(bucket)-1]
+			s.buckets[h] = bucket[:len(bucket)-1]
+		}
+
+		s.len--
+		return
+	}
+}
+
+func (s *Hashed[K]) Contains(item K) bool {
+	for _, existing := range s.buckets[s.hash(item)] {
+		if s.equal(existing, item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Hashed[K]) Len() int {
+	return s.len
+}
+
+func (s *Hashed[K]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, bucket := range s.buckets {
+			for _, item := range bucket {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *Hashed[K]) Slice() []K {
+	items := make([]K, 0, s.len)
+	for item := range s.All() {
+		items = append(items, item)
+	}
+
+	return items
+}
+
+var hashedSeed = maphash.MakeSeed()
+
+// HashFold hashes string so that strings equal under strings.EqualFold have equal hashes
+func HashFold(key string) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashedSeed)
+
+	for _, r := range key {
+		// the least rune of the simple folding orbit is its canonical representative
+		canonical := r
+		for folded := unicode.SimpleFold(r); folded != r; folded = unicode.SimpleFold(folded) {
+			canonical = min(canonical, folded)
+		}
+
+		h.WriteString(string(canonical))
+	}
+
+	return h.Sum64()
+}
+
+// HashReflect hashes any value deeply, so that values equal under reflect.DeepEqual have equal hashes.
+// Cyclic values aren't supported
+func HashReflect[K any](key K) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashedSeed)
+
+	hashValue(&h, reflect.ValueOf(&key).Elem())
+
+	return h.Sum64()
+}
+
+func hashValue(h *maphash.Hash, v reflect.Value) {
+	var buf [8]byte
+
+	writeUint := func(n uint64) {
+		for i := range buf {
+			buf[i] = byte(n >> (8 * i))
+		}
+		_, _ = h.Write(buf[:])
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint(floatBits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		writeUint(floatBits(real(v.Complex())))
+		writeUint(floatBits(imag(v.Complex())))
+	case reflect.String:
+		writeUint(uint64(v.Len()))
+		h.WriteString(v.String())
+	case reflect.Slice, reflect.Array:
+		writeUint(uint64(v.Len()))
+		for i := range v.Len() {
+			hashValue(h, v.Index(i))
+		}
+	case reflect.Map:
+		// map iteration order is random, so entry hashes are combined order independently
+		var sum uint64
+		for key, value := range v.Seq2() {
+			var entry maphash.Hash
+			entry.SetSeed(hashedSeed)
+			hashValue(&entry, key)
+			hashValue(&entry, value)
+			sum += entry.Sum64()
+		}
+		writeUint(uint64(v.Len()))
+		writeUint(sum)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			hashValue(h, v.Field(i))
+		}
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			writeUint(0)
+			return
+		}
+		writeUint(1)
+		hashValue(h, v.Elem())
+	default:
+		// functions and channels are compared by identity
+		if v.IsNil() {
+			writeUint(0)
+			return
+		}
+		writeUint(uint64(v.Pointer()))
+	}
+}
+
+// floatBits treats +0 and -0 the same, since they are equal
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+
+	return math.Float64bits(f)
+}
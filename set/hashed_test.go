@@ -0,0 +1,82 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/leshless/golibrary/set"
+)
+
+func TestHashedFold(t *testing.T) {
+	s := set.NewHashedFold()
+	s.Add("Hello")
+	s.Add("HELLO")
+	s.Add("hello")
+	s.Add("ſtraße")
+	s.Add("STRAßE")
+
+	if s.Len() != 2 || !s.Contains("hElLo") || !s.Contains("Straße") {
+		t.Logf("unexpected set: %q", s.Slice())
+		t.Fail()
+	}
+
+	s.Remove("HeLLo")
+	if s.Len() != 1 || s.Contains("hello") {
+		t.Logf("unexpected set after removal: %q", s.Slice())
+		t.Fail()
+	}
+}
+
+func TestHashedBytes(t *testing.T) {
+	s := set.NewHashedBytes()
+	s.Add([]byte("abc"))
+	s.Add([]byte("abc"))
+	s.Add([]byte{})
+
+	if s.Len() != 2 || !s.Contains([]byte("abc")) || s.Contains([]byte("ab")) {
+		t.Logf("unexpected set: %q", s.Slice())
+		t.Fail()
+	}
+}
+
+type document struct {
+	Tags   []string
+	Meta   map[string]int
+	Parent *document
+	score  float64
+}
+
+func TestHashedReflect(t *testing.T) {
+	s := set.NewHashedReflect[document]()
+
+	s.Add(document{Tags: []string{"a", "b"}, Meta: map[string]int{"x": 1, "y": 2}})
+	s.Add(document{Tags: []string{"a", "b"}, Meta: map[string]int{"y": 2, "x": 1}})
+	s.Add(document{Tags: []string{"a"}, Parent: &document{Tags: []string{"root"}}})
+	s.Add(document{Tags: []string{"a"}, Parent: &document{Tags: []string{"root"}}})
+	s.Add(document{score: 1})
+
+	testCases := []struct {
+		name     string
+		item     document
+		contains bool
+	}{
+		{"SameMap", document{Tags: []string{"a", "b"}, Meta: map[string]int{"x": 1, "y": 2}}, true},
+		{"DifferentMap", document{Tags: []string{"a", "b"}, Meta: map[string]int{"x": 2, "y": 1}}, false},
+		{"SameParent", document{Tags: []string{"a"}, Parent: &document{Tags: []string{"root"}}}, true},
+		{"UnexportedField", document{score: 1}, true},
+		{"UnexportedFieldDiffers", document{score: 2}, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if s.Contains(testCase.item) != testCase.contains {
+				t.Logf("expected contains: %t", testCase.contains)
+				t.Fail()
+			}
+		})
+	}
+
+	if s.Len() != 3 {
+		t.Logf("expected: 3 items, got: %d", s.Len())
+		t.Fail()
+	}
+}
@@ -0,0 +1,124 @@
+package set
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// HyperLogLog estimates number of distinct keys using 2^precision bytes of memory,
+// standard error of the estimate is about 1.04/sqrt(2^precision)
+type HyperLogLog[K any] struct {
+	precision uint8
+	registers []uint8
+	hash      Hasher[K]
+}
+
+var _ encoding.BinaryMarshaler = (*HyperLogLog[string])(nil)
+var _ encoding.BinaryUnmarshaler = (*HyperLogLog[string])(nil)
+
+const (
+	hyperLogLogMinPrecision = 4
+	hyperLogLogMaxPrecision = 18
+)
+
+// NewHyperLogLog creates estimator, precision is clamped to [4, 18] range
+func NewHyperLogLog[K any](precision uint8, hash Hasher[K]) *HyperLogLog[K] {
+	precision = min(max(precision, hyperLogLogMinPrecision), hyperLogLogMaxPrecision)
+
+	return &HyperLogLog[K]{
+		precision: precision,
+		registers: make([]uint8, 1<<precision),
+		hash:      hash,
+	}
+}
+
+func (h *HyperLogLog[K]) Add(key K) {
+	hash := mix(h.hash(key))
+
+	index := hash >> (64 - h.precision)
+	rank := uint8(bits.LeadingZeros64(hash<<h.precision|1<<(h.precision-1))) + 1
+
+	h.registers[index] = max(h.registers[index], rank)
+}
+
+// Count returns estimated number of distinct keys added
+func (h *HyperLogLog[K]) Count() uint64 {
+	m := float64(len(h.registers))
+
+	sum := 0.0
+	zeros := 0
+	for _, register := range h.registers {
+		sum += math.Ldexp(1, -int(register))
+		if register == 0 {
+			zeros++
+		}
+	}
+
+	estimate := h.alpha() * m * m / sum
+	if estimate <= 2.5*m && zeros != 0 {
+		// linear counting is more accurate for small cardinalities
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(math.Round(estimate))
+}
+
+// Merge adds all keys of other estimator, which must have the same precision and hash function
+func (h *HyperLogLog[K]) Merge(other *HyperLogLog[K]) error {
+	if h.precision != other.precision {
+		return errors.New("merging hyperloglogs of different precisions")
+	}
+
+	for i, register := range other.registers {
+		h.registers[i] = max(h.registers[i], register)
+	}
+
+	return nil
+}
+
+// MarshalBinary encodes precision followed by registers, hash function must be provided on decoding
+func (h *HyperLogLog[K]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, len(h.registers)+1)
+	data = append(data, h.precision)
+	data = append(data, h.registers...)
+
+	return data, nil
+}
+
+// UnmarshalBinary restores registers keeping hash function, so it should be called
+// on estimator created by NewHyperLogLog with the same hash function as the encoded one
+func (h *HyperLogLog[K]) UnmarshalBinary(data []byte) error {
+	if h.hash == nil {
+		return errors.New("hyperloglog hash function is not set")
+	}
+
+	if len(data) == 0 {
+		return errors.New("missing hyperloglog precision")
+	}
+
+	precision := data[0]
+	if precision < hyperLogLogMinPrecision || precision > hyperLogLogMaxPrecision || len(data)-1 != 1<<precision {
+		return fmt.Errorf("malformed hyperloglog of precision %d and length %d", precision, len(data))
+	}
+
+	h.precision = precision
+	h.registers = append(make([]uint8, 0, len(data)-1), data[1:]...)
+
+	return nil
+}
+
+func (h *HyperLogLog[K]) alpha() float64 {
+	switch m := len(h.registers); m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(m))
+	}
+}
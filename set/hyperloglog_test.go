@@ -0,0 +1,74 @@
+package set_test
+
+import (
+	"hash/maphash"
+	"math"
+	"testing"
+
+	"github.com/leshless/golibrary/set"
+)
+
+func TestHyperLogLogAccuracy(t *testing.T) {
+	testCases := []struct {
+		name      string
+		precision uint8
+		distinct  int
+	}{
+		{"Tiny", 14, 10},
+		{"Small", 14, 1000},
+		{"Medium", 14, 100000},
+		{"Large", 12, 1000000},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			hll := set.NewHyperLogLog(testCase.precision, set.HashComparable[int](maphash.MakeSeed()))
+			for i := range testCase.distinct {
+				hll.Add(i)
+				hll.Add(i)
+			}
+
+			// 4 standard errors make the test practically never flaky
+			tolerance := 4 * 1.04 / math.Sqrt(float64(uint64(1)<<testCase.precision))
+
+			count := float64(hll.Count())
+			if relative := math.Abs(count-float64(testCase.distinct)) / float64(testCase.distinct); relative > tolerance {
+				t.Logf("estimated %f for %d distinct keys, error %f exceeds %f", count, testCase.distinct, relative, tolerance)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestHyperLogLogMerge(t *testing.T) {
+	a := set.NewHyperLogLog(14, set.HashString)
+	b := set.NewHyperLogLog(14, set.HashString)
+
+	for i := range 20000 {
+		key := string(rune(i))
+		if i < 15000 {
+			a.Add(key)
+		}
+		if i >= 5000 {
+			b.Add(key)
+		}
+	}
+
+	if err := a.Merge(b); err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	data, _ := a.MarshalBinary()
+
+	decoded := set.NewHyperLogLog(4, set.HashString)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Logf("unexpected error: %s", err)
+		t.FailNow()
+	}
+
+	if count := decoded.Count(); count < 19000 || count > 21000 {
+		t.Logf("expected about 20000, got: %d", count)
+		t.Fail()
+	}
+}
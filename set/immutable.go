@@ -0,0 +1,283 @@
+package set
+
+import (
+	"hash/maphash"
+	"iter"
+	"math/bits"
+	"slices"
+)
+
+// Immutable is a persistent set backed by hash array mapped trie. With and Without return new versions
+// which share most of the structure with the original, so sets may be passed around without copying or locking
+type Immutable[K comparable] struct {
+	root *hamtNode[K]
+	len  int
+}
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+var immutableSeed = maphash.MakeSeed()
+
+// hamtNode is either a branch indexed by 5 bits of hash, or a leaf of items with colliding hashes below max depth
+type hamtNode[K comparable] struct {
+	bitmap     uint32
+	entries    []hamtEntry[K]
+	collisions []K
+}
+
+type hamtEntry[K comparable] struct {
+	item  K
+	hash  uint64
+	child *hamtNode[K]
+}
+
+func NewImmutable[K comparable]() Immutable[K] {
+	return Immutable[K]{}
+}
+
+// ImmutableFrom builds set from any other one, nodes are mutated in place while building, so it's cheaper than series of With
+func ImmutableFrom[K comparable](s Readable[K]) Immutable[K] {
+	return ImmutableFromSeq(s.All())
+}
+
+func ImmutableFromSlice[K comparable](items []K) Immutable[K] {
+	return ImmutableFromSeq(slices.Values(items))
+}
+
+func ImmutableFromSeq[K comparable](items iter.Seq[K]) Immutable[K] {
+	root := &hamtNode[K]{}
+	length := 0
+
+	for item := range items {
+		var added bool
+		root, added = root.with(item, maphash.Comparable(immutableSeed, item), 0, true)
+		if added {
+			length++
+		}
+	}
+
+	return Immutable[K]{root: root, len: length}
+}
+
+// With returns set with item added, the original set is left untouched
+func (s Immutable[K]) With(item K) Immutable[K] {
+	root := s.root
+	if root == nil {
+		root = &hamtNode[K]{}
+	}
+
+	root, added := root.with(item, maphash.Comparable(immutableSeed, item), 0, false)
+	if !added {
+		return s
+	}
+
+	return Immutable[K]{root: root, len: s.len + 1}
+}
+
+// Without returns set with item removed, the original set is left untouched
+func (s Immutable[K]) Without(item K) Immutable[K] {
+	if s.root == nil {
+		return s
+	}
+
+	root, removed := s.root.without(item, maphash.Comparable(immutableSeed, item), 0)
+	if !removed {
+		return s
+	}
+
+	return Immutable[K]{root: root, len: s.len - 1}
+}
+
+func (s Immutable[K]) Contains(item K) bool {
+	hash := maphash.Comparable(immutableSeed, item)
+
+	node := s.root
+	for shift := uint(0); node != nil; shift += hamtBits {
+		if shift >= 64 {
+			return slices.Contains(node.collisions, item)
+		}
+
+		bit := uint32(1) << (hash >> shift & hamtMask)
+		if node.bitmap&bit == 0 {
+			return false
+		}
+
+		entry := node.entries[bits.OnesCount32(node.bitmap&(bit-1))]
+		if entry.child == nil {
+			return entry.item == item
+		}
+
+		node = entry.child
+	}
+
+	return false
+}
+
+func (s Immutable[K]) Len() int {
+	return s.len
+}
+
+func (s Immutable[K]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		s.root.walk(yield)
+	}
+}
+
+func (s Immutable[K]) Slice() []K {
+	return slices.AppendSeq(make([]K, 0, s.len), s.All())
+}
+
+// Set returns mutable copy of the set
+func (s Immutable[K]) Set() T[K] {
+	result := make(T[K], s.len)
+	for item := range s.All() {
+		result.Add(item)
+	}
+
+	return result
+}
+
+// with adds item to the subtree, owned nodes are mutated in place instead of being copied
+func (n *hamtNode[K]) with(item K, hash uint64, shift uint, owned bool) (*hamtNode[K], bool) {
+	if shift >= 64 {
+		if slices.Contains(n.collisions, item) {
+			return n, false
+		}
+
+		if owned {
+			n.collisions = append(n.collisions, item)
+			return n, true
+		}
+
+		return &hamtNode[K]{collisions: append(slices.Clip(n.collisions), item)}, true
+	}
+
+	bit := uint32(1) << (hash >> shift & hamtMask)
+	position := bits.OnesCount32(n.bitmap & (bit - 1))
+
+	if n.bitmap&bit == 0 {
+		result := n.copy(owned)
+		result.bitmap |= bit
+		result.entries = slices.Insert(result.entries, position, hamtEntry[K]{item: item, hash: hash})
+
+		return result, true
+	}
+
+	entry := n.entries[position]
+
+	switch {
+	case entry.child != nil:
+		child, added := entry.child.with(item, hash, shift+hamtBits, owned)
+		if !added {
+			return n, false
+		}
+
+		entry = hamtEntry[K]{child: child}
+	case entry.item == item:
+		return n, false
+	default:
+		child, _ := (&hamtNode[K]{}).with(entry.item, entry.hash, shift+hamtBits, true)
+		child, _ = child.with(item, hash, shift+hamtBits, true)
+
+		entry = hamtEntry[K]{child: child}
+	}
+
+	result := n.copy(owned)
+	result.entries[position] = entry
+
+	return result, true
+}
+
+// without removes item from the subtree, nodes are always copied. Subtrees left with a single item are collapsed
+func (n *hamtNode[K]) without(item K, hash uint64, shift uint) (*hamtNode[K], bool) {
+	if shift >= 64 {
+		i := slices.Index(n.collisions, item)
+		if i == -1 {
+			return n, false
+		}
+
+		return &hamtNode[K]{collisions: slices.Delete(slices.Clone(n.collisions), i, i+1)}, true
+	}
+
+	bit := uint32(1) << (hash >> shift & hamtMask)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	position := bits.OnesCount32(n.bitmap & (bit - 1))
+	entry := n.entries[position]
+
+	if entry.child == nil {
+		if entry.item != item {
+			return n, false
+		}
+
+		result := n.copy(false)
+		result.bitmap &^= bit
+		result.entries = slices.Delete(result.entries, position, position+1)
+
+		return result, true
+	}
+
+	child, removed := entry.child.without(item, hash, shift+hamtBits)
+	if !removed {
+		return n, false
+	}
+
+	result := n.copy(false)
+
+	switch {
+	case len(child.entries) == 0 && len(child.collisions) == 0:
+		result.bitmap &^= bit
+		result.entries = slices.Delete(result.entries, position, position+1)
+	case len(child.entries) == 1 && child.entries[0].child == nil:
+		result.entries[position] = child.entries[0]
+	case len(child.entries) == 0 && len(child.collisions) == 1:
+		result.entries[position] = hamtEntry[K]{item: child.collisions[0], hash: hash}
+	default:
+		result.entries[position] = hamtEntry[K]{child: child}
+	}
+
+	return result, true
+}
+
+func (n *hamtNode[K]) copy(owned bool) *hamtNode[K] {
+	if owned {
+		return n
+	}
+
+	return &hamtNode[K]{
+		bitmap:  n.bitmap,
+		entries: slices.Clone(n.entries),
+	}
+}
+
+func (n *hamtNode[K]) walk(yield func(K) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	for _, item := range n.collisions {
+		if !yield(item) {
+			return false
+		}
+	}
+
+	for _, entry := range n.entries {
+		if entry.child != nil {
+			if !entry.child.walk(yield) {
+				return false
+			}
+			continue
+		}
+
+		if !yield(entry.item) {
+			return false
+		}
+	}
+
+	return true
+}
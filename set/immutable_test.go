@@ -0,0 +1,76 @@
+package set_test
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/leshless/golibrary/set"
+	"github.com/leshless/golibrary/sets"
+)
+
+func TestImmutable(t *testing.T) {
+	random := rand.New(rand.NewPCG(3, 4))
+
+	versions := []set.Immutable[int]{set.NewImmutable[int]()}
+	references := []set.T[int]{set.New[int]()}
+
+	for range 2000 {
+		current := versions[len(versions)-1]
+		reference := maps.Clone(references[len(references)-1])
+
+		item := random.IntN(500)
+		if random.IntN(3) == 0 {
+			current = current.Without(item)
+			reference.Remove(item)
+		} else {
+			current = current.With(item)
+			reference.Add(item)
+		}
+
+		versions = append(versions, current)
+		references = append(references, reference)
+	}
+
+	// every version must stay intact after the later ones were derived from it
+	for i := range versions {
+		if !sets.Equal[int](versions[i], references[i]) || versions[i].Len() != references[i].Len() {
+			t.Logf("version %d diverged from reference", i)
+			t.FailNow()
+		}
+	}
+
+	last := versions[len(versions)-1]
+	for item := range 500 {
+		if last.Contains(item) != references[len(references)-1].Contains(item) {
+			t.Logf("unexpected contains result for %d", item)
+			t.FailNow()
+		}
+	}
+}
+
+func TestImmutableConversions(t *testing.T) {
+	source := set.FromSlice([]int{1, 2, 3, 4, 5})
+
+	immutable := set.ImmutableFrom[int](source)
+	derived := immutable.Without(1).With(6)
+
+	if result := slices.Sorted(immutable.All()); slices.Compare(result, []int{1, 2, 3, 4, 5}) != 0 {
+		t.Logf("original changed: %v", result)
+		t.Fail()
+	}
+
+	if result := slices.Sorted(derived.All()); slices.Compare(result, []int{2, 3, 4, 5, 6}) != 0 {
+		t.Logf("unexpected derived: %v", result)
+		t.Fail()
+	}
+
+	mutable := derived.Set()
+	mutable.Add(7)
+
+	if derived.Contains(7) || sets.Intersection[int](immutable, derived).Len() != 4 {
+		t.Logf("unexpected interop result")
+		t.Fail()
+	}
+}
@@ -0,0 +1,29 @@
+package set
+
+import "iter"
+
+// Readable is implemented by every set of the package including immutable ones
+type Readable[K comparable] interface {
+	Contains(item K) bool
+	Len() int
+	All() iter.Seq[K]
+}
+
+// Interface is implemented by every mutable set of the package, so that algorithms may work with any of them
+type Interface[K comparable] interface {
+	Readable[K]
+	Add(item K)
+}
+
+// Mutable is Interface which also supports removal
+type Mutable[K comparable] interface {
+	Interface[K]
+	Remove(item K)
+}
+
+var _ Mutable[int] = T[int]{}
+var _ Mutable[int] = (*Ordered[int])(nil)
+var _ Mutable[int] = (*Sorted[int])(nil)
+var _ Mutable[int] = (*Concurrent[int])(nil)
+var _ Readable[uint] = (*Bitset[uint])(nil)
+var _ Readable[int] = Immutable[int]{}
@@ -0,0 +1,130 @@
+package set
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/leshless/golibrary/pair"
+)
+
+// Multi is a multiset, which counts occurrences of every item. Only positive counts are stored
+type Multi[K comparable] map[K]int
+
+var _ json.Unmarshaler = (*Multi[string])(nil)
+
+func NewMulti[K comparable]() Multi[K] {
+	return make(Multi[K])
+}
+
+func MultiFromSlice[K comparable](items []K) Multi[K] {
+	m := make(Multi[K])
+	for _, item := range items {
+		m.Add(item, 1)
+	}
+
+	return m
+}
+
+// Add increases item count by n, non-positive n is ignored
+func (m Multi[K]) Add(item K, n int) {
+	if n <= 0 {
+		return
+	}
+
+	m[item] += n
+}
+
+// Remove decreases item count by n, item is removed completely once its count drops to zero
+func (m Multi[K]) Remove(item K, n int) {
+	if n <= 0 {
+		return
+	}
+
+	if m[item] <= n {
+		delete(m, item)
+		return
+	}
+
+	m[item] -= n
+}
+
+func (m Multi[K]) Count(item K) int {
+	return m[item]
+}
+
+func (m Multi[K]) Contains(item K) bool {
+	_, exists := m[item]
+	return exists
+}
+
+// Len returns number of distinct items
+func (m Multi[K]) Len() int {
+	return len(m)
+}
+
+// Total returns sum of all counts
+func (m Multi[K]) Total() int {
+	total := 0
+	for _, count := range m {
+		total += count
+	}
+
+	return total
+}
+
+func (m Multi[K]) Distinct() T[K] {
+	s := make(T[K], len(m))
+	for item := range m {
+		s.Add(item)
+	}
+
+	return s
+}
+
+// All iterates over distinct items with their counts
+func (m Multi[K]) All() iter.Seq2[K, int] {
+	return func(yield func(K, int) bool) {
+		for item, count := range m {
+			if !yield(item, count) {
+				return
+			}
+		}
+	}
+}
+
+// MostCommon returns up to n items with the greatest counts in descending order, order of ties is unspecified
+func (m Multi[K]) MostCommon(n int) []pair.T[K, int] {
+	counts := make([]pair.T[K, int], 0, len(m))
+	for item, count := range m {
+		counts = append(counts, pair.New(item, count))
+	}
+
+	slices.SortFunc(counts, func(a, b pair.T[K, int]) int {
+		return cmp.Compare(b.Second, a.Second)
+	})
+
+	return counts[:min(max(n, 0), len(counts))]
+}
+
+// UnmarshalJSON decodes multiset from an object of counts, non-positive counts are rejected
+func (m *Multi[K]) UnmarshalJSON(data []byte) error {
+	var counts map[K]int
+	if err := json.Unmarshal(data, &counts); err != nil {
+		return err
+	}
+
+	result := make(Multi[K], len(counts))
+	for item, count := range counts {
+		if count <= 0 {
+			return fmt.Errorf("non-positive count %d of item %v", count, item)
+		}
+
+		result[item] = count
+	}
+
+	*m = result
+	return nil
+}
@@ -0,0 +1,51 @@
+package set_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/leshless/golibrary/pair"
+	"github.com/leshless/golibrary/set"
+)
+
+func TestMulti(t *testing.T) {
+	m := set.MultiFromSlice([]string{"a", "b", "a", "c", "a", "b"})
+
+	m.Remove("c", 5)
+	m.Add("d", 0)
+
+	if m.Count("a") != 3 || m.Count("b") != 2 || m.Contains("c") || m.Contains("d") || m.Total() != 5 {
+		t.Logf("unexpected multiset: %v", m)
+		t.Fail()
+	}
+
+	mostCommon := m.MostCommon(1)
+	if len(mostCommon) != 1 || mostCommon[0] != pair.New("a", 3) {
+		t.Logf("unexpected most common: %v", mostCommon)
+		t.Fail()
+	}
+
+	if distinct := m.Distinct(); distinct.Len() != 2 || !distinct.Contains("b") {
+		t.Logf("unexpected distinct: %v", distinct.Slice())
+		t.Fail()
+	}
+}
+
+func TestMultiJSON(t *testing.T) {
+	output, err := json.Marshal(set.MultiFromSlice([]string{"b", "a", "b"}))
+	if err != nil || string(output) != `{"a":1,"b":2}` {
+		t.Logf("unexpected output: %s, %v", output, err)
+		t.Fail()
+	}
+
+	var decoded set.Multi[string]
+	if err := json.Unmarshal(output, &decoded); err != nil || decoded.Count("b") != 2 {
+		t.Logf("unexpected result: %v, %v", decoded, err)
+		t.Fail()
+	}
+
+	if err := json.Unmarshal([]byte(`{"a":0}`), &decoded); err == nil {
+		t.Logf("expected error for zero count")
+		t.Fail()
+	}
+}
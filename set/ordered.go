@@ -0,0 +1,104 @@
+package set
+
+import "iter"
+
+// Ordered is a set which keeps items in insertion order, all operations are O(1)
+type Ordered[K comparable] struct {
+	nodes map[K]*orderedNode[K]
+	head  *orderedNode[K]
+	tail  *orderedNode[K]
+}
+
+type orderedNode[K comparable] struct {
+	item K
+	prev *orderedNode[K]
+	next *orderedNode[K]
+}
+
+func NewOrdered[K comparable]() *Ordered[K] {
+	return &Ordered[K]{
+		nodes: make(map[K]*orderedNode[K]),
+	}
+}
+
+func OrderedFromSlice[K comparable](items []K) *Ordered[K] {
+	s := &Ordered[K]{
+		nodes: make(map[K]*orderedNode[K], len(items)),
+	}
+	for _, item := range items {
+		s.Add(item)
+	}
+
+	return s
+}
+
+// Add appends item to the end, adding existing item doesn't change its position
+func (s *Ordered[K]) Add(item K) {
+	if _, exists := s.nodes[item]; exists {
+		return
+	}
+
+	node := &orderedNode[K]{
+		item: item,
+		prev: s.tail,
+	}
+
+	if s.tail != nil {
+		s.tail.next = node
+	} else {
+		s.head = node
+	}
+
+	s.tail = node
+	s.nodes[item] = node
+}
+
+func (s *Ordered[K]) Remove(item K) {
+	node, exists := s.nodes[item]
+	if !exists {
+		return
+	}
+
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		s.head = node.next
+	}
+
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		s.tail = node.prev
+	}
+
+	delete(s.nodes, item)
+}
+
+func (s *Ordered[K]) Contains(item K) bool {
+	_, exists := s.nodes[item]
+	return exists
+}
+
+func (s *Ordered[K]) Len() int {
+	return len(s.nodes)
+}
+
+func (s *Ordered[K]) Slice() []K {
+	keys := make([]K, 0, len(s.nodes))
+	for node := s.head; node != nil; node = node.next {
+		keys = append(keys, node.item)
+	}
+
+	return keys
+}
+
+// All iterates over items in insertion order
+func (s *Ordered[K]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for node := s.head; node != nil; node = node.next {
+			if !yield(node.item) {
+				return
+			}
+		}
+	}
+}
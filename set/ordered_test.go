@@ -0,0 +1,67 @@
+package set_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/leshless/golibrary/set"
+)
+
+func TestOrdered(t *testing.T) {
+	testCases := []struct {
+		name   string
+		apply  func(s *set.Ordered[string])
+		result []string
+	}{
+		{
+			name:   "Empty",
+			apply:  func(s *set.Ordered[string]) {},
+			result: []string{},
+		},
+		{
+			name: "InsertionOrder",
+			apply: func(s *set.Ordered[string]) {
+				s.Add("c")
+				s.Add("a")
+				s.Add("b")
+				s.Add("a")
+			},
+			result: []string{"c", "a", "b"},
+		},
+		{
+			name: "Remove",
+			apply: func(s *set.Ordered[string]) {
+				s.Add("a")
+				s.Add("b")
+				s.Add("c")
+				s.Remove("a")
+				s.Remove("c")
+				s.Remove("d")
+				s.Add("a")
+			},
+			result: []string{"b", "a"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := set.NewOrdered[string]()
+			testCase.apply(s)
+
+			if result := s.Slice(); slices.Compare(result, testCase.result) != 0 {
+				t.Logf("expected: %v, got: %v", testCase.result, result)
+				t.Fail()
+			}
+
+			if result := slices.Collect(s.All()); slices.Compare(result, testCase.result) != 0 {
+				t.Logf("expected: %v, got: %v", testCase.result, result)
+				t.Fail()
+			}
+
+			if s.Len() != len(testCase.result) {
+				t.Logf("expected len: %d, got: %d", len(testCase.result), s.Len())
+				t.Fail()
+			}
+		})
+	}
+}
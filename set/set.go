@@ -1,5 +1,7 @@
 package set
 
+import "iter"
+
 type T[K comparable] map[K]struct{}
 
 func New[K comparable]() T[K] {
@@ -28,6 +30,20 @@ func (s T[K]) Contains(item K) bool {
 	return exists
 }
 
+func (s T[K]) Len() int {
+	return len(s)
+}
+
+func (s T[K]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for item := range s {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
 func (s T[K]) Slice() []K {
 	keys := make([]K, 0, len(s))
 	for k := range s {
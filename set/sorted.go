@@ -0,0 +1,337 @@
+package set
+
+import (
+	"cmp"
+	"iter"
+
+	"github.com/leshless/golibrary/optional"
+)
+
+// Sorted is a set which keeps items ordered by comparator, backed by AVL tree,
+// so that all single item operations are O(log n)
+type Sorted[K any] struct {
+	root    *sortedNode[K]
+	compare func(a, b K) int
+}
+
+type sortedNode[K any] struct {
+	item   K
+	left   *sortedNode[K]
+	right  *sortedNode[K]
+	height int
+	size   int
+}
+
+func NewSorted[K cmp.Ordered]() *Sorted[K] {
+	return NewSortedFunc(cmp.Compare[K])
+}
+
+// NewSortedFunc creates sorted set with custom comparator, which also defines item equality
+func NewSortedFunc[K any](compare func(a, b K) int) *Sorted[K] {
+	return &Sorted[K]{
+		compare: compare,
+	}
+}
+
+func SortedFromSlice[K cmp.Ordered](items []K) *Sorted[K] {
+	s := NewSorted[K]()
+	for _, item := range items {
+		s.Add(item)
+	}
+
+	return s
+}
+
+func (s *Sorted[K]) Add(item K) {
+	s.root = s.insert(s.root, item)
+}
+
+func (s *Sorted[K]) Remove(item K) {
+	s.root = s.delete(s.root, item)
+}
+
+func (s *Sorted[K]) Contains(item K) bool {
+	node := s.root
+	for node != nil {
+		switch c := s.compare(item, node.item); {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Sorted[K]) Len() int {
+	return s.root.getSize()
+}
+
+// Slice returns items in ascending order
+func (s *Sorted[K]) Slice() []K {
+	keys := make([]K, 0, s.Len())
+	for item := range s.All() {
+		keys = append(keys, item)
+	}
+
+	return keys
+}
+
+// All iterates over items in ascending order
+func (s *Sorted[K]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		s.root.ascend(yield, func(K) bool { return true }, func(K) bool { return true })
+	}
+}
+
+// Backward iterates over items in descending order
+func (s *Sorted[K]) Backward() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		s.root.descend(yield)
+	}
+}
+
+// Range iterates in ascending order over items in [lo, hi] interval
+func (s *Sorted[K]) Range(lo, hi K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		s.root.ascend(
+			yield,
+			func(item K) bool { return s.compare(item, lo) >= 0 },
+			func(item K) bool { return s.compare(item, hi) <= 0 },
+		)
+	}
+}
+
+func (s *Sorted[K]) Min() optional.T[K] {
+	node := s.root
+	if node == nil {
+		return optional.None[K]()
+	}
+
+	for node.left != nil {
+		node = node.left
+	}
+
+	return optional.Some(node.item)
+}
+
+func (s *Sorted[K]) Max() optional.T[K] {
+	node := s.root
+	if node == nil {
+		return optional.None[K]()
+	}
+
+	for node.right != nil {
+		node = node.right
+	}
+
+	return optional.Some(node.item)
+}
+
+// Floor returns the greatest item less than or equal to the given one
+func (s *Sorted[K]) Floor(item K) optional.T[K] {
+	result := optional.None[K]()
+
+	node := s.root
+	for node != nil {
+		switch c := s.compare(item, node.item); {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			result = optional.Some(node.item)
+			node = node.right
+		default:
+			return optional.Some(node.item)
+		}
+	}
+
+	return result
+}
+
+// Ceiling returns the least item greater than or equal to the given one
+func (s *Sorted[K]) Ceiling(item K) optional.T[K] {
+	result := optional.None[K]()
+
+	node := s.root
+	for node != nil {
+		switch c := s.compare(item, node.item); {
+		case c < 0:
+			result = optional.Some(node.item)
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return optional.Some(node.item)
+		}
+	}
+
+	return result
+}
+
+// Rank returns number of items strictly less than the given one
+func (s *Sorted[K]) Rank(item K) int {
+	rank := 0
+
+	node := s.root
+	for node != nil {
+		switch c := s.compare(item, node.item); {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			rank += node.left.getSize() + 1
+			node = node.right
+		default:
+			return rank + node.left.getSize()
+		}
+	}
+
+	return rank
+}
+
+func (s *Sorted[K]) insert(node *sortedNode[K], item K) *sortedNode[K] {
+	if node == nil {
+		return &sortedNode[K]{
+			item:   item,
+			height: 1,
+			size:   1,
+		}
+	}
+
+	switch c := s.compare(item, node.item); {
+	case c < 0:
+		node.left = s.insert(node.left, item)
+	case c > 0:
+		node.right = s.insert(node.right, item)
+	default:
+		return node
+	}
+
+	return node.balance()
+}
+
+func (s *Sorted[K]) delete(node *sortedNode[K], item K) *sortedNode[K] {
+	if node == nil {
+		return nil
+	}
+
+	switch c := s.compare(item, node.item); {
+	case c < 0:
+		node.left = s.delete(node.left, item)
+	case c > 0:
+		node.right = s.delete(node.right, item)
+	default:
+		if node.left == nil {
+			return node.right
+		}
+		if node.right == nil {
+			return node.left
+		}
+
+		successor := node.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+
+		node.item = successor.item
+		node.right = s.delete(node.right, successor.item)
+	}
+
+	return node.balance()
+}
+
+func (n *sortedNode[K]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+func (n *sortedNode[K]) getSize() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+func (n *sortedNode[K]) update() {
+	n.height = max(n.left.getHeight(), n.right.getHeight()) + 1
+	n.size = n.left.getSize() + n.right.getSize() + 1
+}
+
+func (n *sortedNode[K]) rotateLeft() *sortedNode[K] {
+	right := n.right
+	n.right = right.left
+	right.left = n
+
+	n.update()
+	right.update()
+
+	return right
+}
+
+func (n *sortedNode[K]) rotateRight() *sortedNode[K] {
+	left := n.left
+	n.left = left.right
+	left.right = n
+
+	n.update()
+	left.update()
+
+	return left
+}
+
+func (n *sortedNode[K]) balance() *sortedNode[K] {
+	n.update()
+
+	switch factor := n.left.getHeight() - n.right.getHeight(); {
+	case factor > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case factor < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	default:
+		return n
+	}
+}
+
+// ascend walks subtree in order skipping items which are not above lower bound or not below upper bound
+func (n *sortedNode[K]) ascend(yield func(K) bool, aboveLo, belowHi func(K) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	isAboveLo := aboveLo(n.item)
+	isBelowHi := belowHi(n.item)
+
+	if isAboveLo && !n.left.ascend(yield, aboveLo, belowHi) {
+		return false
+	}
+
+	if isAboveLo && isBelowHi && !yield(n.item) {
+		return false
+	}
+
+	if isBelowHi {
+		return n.right.ascend(yield, aboveLo, belowHi)
+	}
+
+	return true
+}
+
+func (n *sortedNode[K]) descend(yield func(K) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	return n.right.descend(yield) && yield(n.item) && n.left.descend(yield)
+}
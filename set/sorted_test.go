@@ -0,0 +1,93 @@
+package set_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+	"github.com/leshless/golibrary/set"
+)
+
+func TestSorted(t *testing.T) {
+	s := set.SortedFromSlice([]int{50, 10, 40, 20, 30})
+
+	testCases := []struct {
+		name   string
+		result optional.T[int]
+		expect optional.T[int]
+	}{
+		{"Min", s.Min(), optional.Some(10)},
+		{"Max", s.Max(), optional.Some(50)},
+		{"FloorExact", s.Floor(30), optional.Some(30)},
+		{"FloorBetween", s.Floor(35), optional.Some(30)},
+		{"FloorBelow", s.Floor(5), optional.None[int]()},
+		{"CeilingBetween", s.Ceiling(35), optional.Some(40)},
+		{"CeilingAbove", s.Ceiling(55), optional.None[int]()},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.result != testCase.expect {
+				t.Logf("expected: %v, got: %v", testCase.expect, testCase.result)
+				t.Fail()
+			}
+		})
+	}
+
+	t.Run("Range", func(t *testing.T) {
+		result := slices.Collect(s.Range(15, 40))
+		if slices.Compare(result, []int{20, 30, 40}) != 0 {
+			t.Logf("expected: %v, got: %v", []int{20, 30, 40}, result)
+			t.Fail()
+		}
+	})
+
+	t.Run("Backward", func(t *testing.T) {
+		result := slices.Collect(s.Backward())
+		if slices.Compare(result, []int{50, 40, 30, 20, 10}) != 0 {
+			t.Logf("expected: %v, got: %v", []int{50, 40, 30, 20, 10}, result)
+			t.Fail()
+		}
+	})
+
+	t.Run("Rank", func(t *testing.T) {
+		if s.Rank(10) != 0 || s.Rank(35) != 3 || s.Rank(100) != 5 {
+			t.Logf("unexpected ranks: %d, %d, %d", s.Rank(10), s.Rank(35), s.Rank(100))
+			t.Fail()
+		}
+	})
+}
+
+func TestSortedRandom(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	s := set.NewSorted[int]()
+	reference := set.New[int]()
+
+	for range 10000 {
+		item := random.IntN(1000)
+		if random.IntN(3) == 0 {
+			s.Remove(item)
+			reference.Remove(item)
+		} else {
+			s.Add(item)
+			reference.Add(item)
+		}
+	}
+
+	expected := reference.Slice()
+	slices.Sort(expected)
+
+	if result := s.Slice(); slices.Compare(result, expected) != 0 || s.Len() != len(expected) {
+		t.Logf("sorted set diverged from reference, len: %d, expected: %d", s.Len(), len(expected))
+		t.Fail()
+	}
+
+	for i, item := range expected {
+		if s.Rank(item) != i || !s.Contains(item) {
+			t.Logf("unexpected rank of %d: %d", item, s.Rank(item))
+			t.Fail()
+			break
+		}
+	}
+}
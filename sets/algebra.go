@@ -0,0 +1,131 @@
+package sets
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/leshless/golibrary/pair"
+	"github.com/leshless/golibrary/set"
+)
+
+func Equal[K comparable](a, b set.Readable[K]) bool {
+	return a.Len() == b.Len() && IsSubset(a, b)
+}
+
+func IsSuperset[K comparable](superset, set set.Readable[K]) bool {
+	return IsSubset(set, superset)
+}
+
+func IsProperSubset[K comparable](subset, set set.Readable[K]) bool {
+	return subset.Len() < set.Len() && IsSubset(subset, set)
+}
+
+func IsDisjoint[K comparable](a, b set.Readable[K]) bool {
+	if a.Len() > b.Len() {
+		a, b = b, a
+	}
+
+	for item := range a.All() {
+		if b.Contains(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func UnionAll[K comparable](sets ...set.Readable[K]) set.T[K] {
+	size := 0
+	for _, s := range sets {
+		size = max(size, s.Len())
+	}
+
+	result := make(set.T[K], size)
+	for _, s := range sets {
+		UnionWith(result, s)
+	}
+
+	return result
+}
+
+// IntersectAll starts from the smallest set, so that result never grows above its size
+func IntersectAll[K comparable](sets ...set.Readable[K]) set.T[K] {
+	if len(sets) == 0 {
+		return set.New[K]()
+	}
+
+	smallest := slices.MinFunc(sets, func(a, b set.Readable[K]) int {
+		return a.Len() - b.Len()
+	})
+
+	result := set.New[K]()
+	for item := range smallest.All() {
+		if slices.ContainsFunc(sets, func(s set.Readable[K]) bool { return !s.Contains(item) }) {
+			continue
+		}
+
+		result.Add(item)
+	}
+
+	return result
+}
+
+// PowerSet lazily yields all 2^n subsets of s, starting from the empty one
+func PowerSet[K comparable](s set.Readable[K]) iter.Seq[set.T[K]] {
+	return func(yield func(set.T[K]) bool) {
+		items := slices.Collect(s.All())
+		included := make([]bool, len(items))
+
+		for {
+			subset := set.New[K]()
+			for i, item := range items {
+				if included[i] {
+					subset.Add(item)
+				}
+			}
+
+			if !yield(subset) {
+				return
+			}
+
+			// increment binary counter, overflow means all subsets were yielded
+			i := 0
+			for i < len(included) && included[i] {
+				included[i] = false
+				i++
+			}
+
+			if i == len(included) {
+				return
+			}
+
+			included[i] = true
+		}
+	}
+}
+
+func CartesianProduct[A comparable, B comparable](a set.Readable[A], b set.Readable[B]) set.T[pair.T[A, B]] {
+	result := make(set.T[pair.T[A, B]], a.Len()*b.Len())
+	for first := range a.All() {
+		for second := range b.All() {
+			result.Add(pair.New(first, second))
+		}
+	}
+
+	return result
+}
+
+// Partition splits set into items which satisfy predicate and the rest
+func Partition[K comparable](s set.Readable[K], predicate func(item K) bool) (set.T[K], set.T[K]) {
+	matched := set.New[K]()
+	rest := set.New[K]()
+	for item := range s.All() {
+		if predicate(item) {
+			matched.Add(item)
+		} else {
+			rest.Add(item)
+		}
+	}
+
+	return matched, rest
+}
@@ -0,0 +1,145 @@
+package sets_test
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/leshless/golibrary/pair"
+	"github.com/leshless/golibrary/set"
+	"github.com/leshless/golibrary/sets"
+)
+
+func TestLaws(t *testing.T) {
+	testCases := []struct {
+		name string
+		law  func(a, b, c []uint8) bool
+	}{
+		{
+			name: "UnionCommutativity",
+			law: func(a, b, _ []uint8) bool {
+				x, y := set.FromSlice(a), set.FromSlice(b)
+				return sets.Equal(sets.Union(x, y), sets.Union(y, x))
+			},
+		},
+		{
+			name: "IntersectionAssociativity",
+			law: func(a, b, c []uint8) bool {
+				x, y, z := set.FromSlice(a), set.FromSlice(b), set.FromSlice(c)
+				return sets.Equal(sets.Intersection(sets.Intersection(x, y), z), sets.Intersection(x, sets.Intersection(y, z)))
+			},
+		},
+		{
+			name: "Distributivity",
+			law: func(a, b, c []uint8) bool {
+				x, y, z := set.FromSlice(a), set.FromSlice(b), set.FromSlice(c)
+				return sets.Equal(sets.Intersection(x, sets.Union(y, z)), sets.Union(sets.Intersection(x, y), sets.Intersection(x, z)))
+			},
+		},
+		{
+			name: "DeMorgan",
+			law: func(a, b, c []uint8) bool {
+				x, y, z := set.FromSlice(a), set.FromSlice(b), set.FromSlice(c)
+				return sets.Equal(sets.Diff(x, sets.Union(y, z)), sets.Intersection(sets.Diff(x, y), sets.Diff(x, z)))
+			},
+		},
+		{
+			name: "SymDiffDefinition",
+			law: func(a, b, _ []uint8) bool {
+				x, y := set.FromSlice(a), set.FromSlice(b)
+				return sets.Equal(sets.SymDiff(x, y), sets.Diff(sets.Union(x, y), sets.Intersection(x, y)))
+			},
+		},
+		{
+			name: "SubsetOfUnion",
+			law: func(a, b, _ []uint8) bool {
+				x, y := set.FromSlice(a), set.FromSlice(b)
+				union := sets.Union(x, y)
+				return sets.IsSubset(x, union) && sets.IsSuperset(union, y)
+			},
+		},
+		{
+			name: "DisjointDiff",
+			law: func(a, b, _ []uint8) bool {
+				x, y := set.FromSlice(a), set.FromSlice(b)
+				return sets.IsDisjoint(sets.Diff(x, y), y)
+			},
+		},
+		{
+			name: "ProperSubset",
+			law: func(a, b, _ []uint8) bool {
+				x, y := set.FromSlice(a), set.FromSlice(b)
+				return sets.IsProperSubset(x, y) == (sets.IsSubset(x, y) && !sets.Equal(x, y))
+			},
+		},
+		{
+			name: "VariadicMatchesBinary",
+			law: func(a, b, c []uint8) bool {
+				x, y, z := set.FromSlice(a), set.FromSlice(b), set.FromSlice(c)
+				return sets.Equal(sets.UnionAll[uint8](x, y, z), sets.Union(sets.Union(x, y), z)) &&
+					sets.Equal(sets.IntersectAll[uint8](x, y, z), sets.Intersection(sets.Intersection(x, y), z))
+			},
+		},
+		{
+			name: "PartitionCovers",
+			law: func(a, _, _ []uint8) bool {
+				x := set.FromSlice(a)
+				even, odd := sets.Partition(x, func(n uint8) bool { return n%2 == 0 })
+				return sets.IsDisjoint(even, odd) && sets.Equal(sets.Union(even, odd), x)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if err := quick.Check(testCase.law, nil); err != nil {
+				t.Log(err)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	s := set.FromSlice([]int{1, 2, 3, 4})
+
+	subsets := make([]set.T[int], 0)
+	for subset := range sets.PowerSet(s) {
+		if !sets.IsSubset(subset, s) {
+			t.Logf("unexpected subset: %v", subset.Slice())
+			t.Fail()
+		}
+
+		for _, other := range subsets {
+			if sets.Equal(subset, other) {
+				t.Logf("duplicate subset: %v", subset.Slice())
+				t.Fail()
+			}
+		}
+
+		subsets = append(subsets, subset)
+	}
+
+	if len(subsets) != 16 {
+		t.Logf("expected: 16 subsets, got: %d", len(subsets))
+		t.Fail()
+	}
+
+	count := 0
+	for range sets.PowerSet(set.New[int]()) {
+		count++
+	}
+
+	if count != 1 {
+		t.Logf("expected: 1 subset of empty set, got: %d", count)
+		t.Fail()
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	product := sets.CartesianProduct(set.FromSlice([]int{1, 2}), set.FromSlice([]string{"a", "b", "c"}))
+
+	if product.Len() != 6 || !product.Contains(pair.New(2, "c")) {
+		t.Logf("unexpected product: %v", product.Slice())
+		t.Fail()
+	}
+}
@@ -2,21 +2,21 @@ package sets
 
 import "github.com/leshless/golibrary/set"
 
-func Union[K comparable](a, b set.T[K]) set.T[K] {
-	result := set.New[K]()
-	for item := range a {
-		result.Add(item)
-	}
-	for item := range b {
-		result.Add(item)
-	}
+func Union[K comparable](a, b set.Readable[K]) set.T[K] {
+	result := make(set.T[K], max(a.Len(), b.Len()))
+	UnionWith(result, a)
+	UnionWith(result, b)
 
 	return result
 }
 
-func Intersection[K comparable](a, b set.T[K]) set.T[K] {
+func Intersection[K comparable](a, b set.Readable[K]) set.T[K] {
+	if a.Len() > b.Len() {
+		a, b = b, a
+	}
+
 	result := set.New[K]()
-	for item := range a {
+	for item := range a.All() {
 		if b.Contains(item) {
 			result.Add(item)
 		}
@@ -25,34 +25,39 @@ func Intersection[K comparable](a, b set.T[K]) set.T[K] {
 	return result
 }
 
-func Diff[K comparable](a, b set.T[K]) set.T[K] {
+func Diff[K comparable](a, b set.Readable[K]) set.T[K] {
 	result := set.New[K]()
-	for item := range a {
+	for item := range a.All() {
 		if !b.Contains(item) {
 			result.Add(item)
 		}
 	}
+
 	return result
 }
 
-func SymDiff[K comparable](a, b set.T[K]) set.T[K] {
+func SymDiff[K comparable](a, b set.Readable[K]) set.T[K] {
 	result := set.New[K]()
-	for item := range a {
+	for item := range a.All() {
 		if !b.Contains(item) {
 			result.Add(item)
 		}
 	}
-	for item := range b {
+	for item := range b.All() {
 		if !a.Contains(item) {
-			a.Add(item)
+			result.Add(item)
 		}
 	}
 
 	return result
 }
 
-func IsSubset[K comparable](subset, set set.T[K]) bool {
-	for item := range subset {
+func IsSubset[K comparable](subset, set set.Readable[K]) bool {
+	if subset.Len() > set.Len() {
+		return false
+	}
+
+	for item := range subset.All() {
 		if !set.Contains(item) {
 			return false
 		}
@@ -60,3 +65,47 @@ func IsSubset[K comparable](subset, set set.T[K]) bool {
 
 	return true
 }
+
+// UnionWith adds all items of src to dst
+func UnionWith[K comparable](dst set.Interface[K], src set.Readable[K]) {
+	for item := range src.All() {
+		dst.Add(item)
+	}
+}
+
+// IntersectWith removes items of dst which are missing in src
+func IntersectWith[K comparable](dst set.Mutable[K], src set.Readable[K]) {
+	// removal while iterating isn't safe for every set, so items are collected first
+	removed := make([]K, 0)
+	for item := range dst.All() {
+		if !src.Contains(item) {
+			removed = append(removed, item)
+		}
+	}
+
+	for _, item := range removed {
+		dst.Remove(item)
+	}
+}
+
+// Subtract removes items of src from dst
+func Subtract[K comparable](dst set.Mutable[K], src set.Readable[K]) {
+	if dst.Len() < src.Len() {
+		removed := make([]K, 0)
+		for item := range dst.All() {
+			if src.Contains(item) {
+				removed = append(removed, item)
+			}
+		}
+
+		for _, item := range removed {
+			dst.Remove(item)
+		}
+
+		return
+	}
+
+	for item := range src.All() {
+		dst.Remove(item)
+	}
+}
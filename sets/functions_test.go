@@ -0,0 +1,117 @@
+package sets_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/leshless/golibrary/set"
+	"github.com/leshless/golibrary/sets"
+)
+
+func sorted(s set.T[int]) []int {
+	items := s.Slice()
+	slices.Sort(items)
+
+	return items
+}
+
+func TestOperations(t *testing.T) {
+	a := set.FromSlice([]int{1, 2, 3, 4})
+	b := set.SortedFromSlice([]int{3, 4, 5})
+
+	testCases := []struct {
+		name   string
+		result set.T[int]
+		expect []int
+	}{
+		{"Union", sets.Union[int](a, b), []int{1, 2, 3, 4, 5}},
+		{"Intersection", sets.Intersection[int](a, b), []int{3, 4}},
+		{"Diff", sets.Diff[int](a, b), []int{1, 2}},
+		{"SymDiff", sets.SymDiff[int](a, b), []int{1, 2, 5}},
+		{"SymDiffSame", sets.SymDiff(a, a), []int{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := sorted(testCase.result); slices.Compare(result, testCase.expect) != 0 {
+				t.Logf("expected: %v, got: %v", testCase.expect, result)
+				t.Fail()
+			}
+		})
+	}
+
+	if a.Len() != 4 || b.Len() != 3 {
+		t.Logf("operands must not be modified, got: %v, %v", a.Slice(), b.Slice())
+		t.Fail()
+	}
+}
+
+func TestInPlace(t *testing.T) {
+	testCases := []struct {
+		name   string
+		apply  func(dst set.Mutable[int], src set.Readable[int])
+		expect []int
+	}{
+		{"UnionWith", func(dst set.Mutable[int], src set.Readable[int]) { sets.UnionWith(dst, src) }, []int{1, 2, 3, 4, 5}},
+		{"IntersectWith", sets.IntersectWith[int], []int{3, 4}},
+		{"Subtract", sets.Subtract[int], []int{1, 2}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dsts := []set.Mutable[int]{
+				set.FromSlice([]int{1, 2, 3, 4}),
+				set.OrderedFromSlice([]int{1, 2, 3, 4}),
+				set.SortedFromSlice([]int{1, 2, 3, 4}),
+			}
+
+			for _, dst := range dsts {
+				testCase.apply(dst, set.FromSlice([]int{3, 4, 5}))
+
+				result := slices.Sorted(dst.All())
+				if slices.Compare(result, testCase.expect) != 0 {
+					t.Logf("%T expected: %v, got: %v", dst, testCase.expect, result)
+					t.Fail()
+				}
+			}
+		})
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	if !sets.IsSubset(set.FromSlice([]int{1, 2}), set.FromSlice([]int{1, 2, 3})) {
+		t.Logf("expected subset")
+		t.Fail()
+	}
+
+	if sets.IsSubset(set.FromSlice([]int{1, 4}), set.FromSlice([]int{1, 2, 3})) {
+		t.Logf("unexpected subset")
+		t.Fail()
+	}
+}
+
+func TestMulti(t *testing.T) {
+	a := set.MultiFromSlice([]string{"x", "x", "x", "y"})
+	b := set.MultiFromSlice([]string{"x", "y", "y", "z"})
+
+	testCases := []struct {
+		name   string
+		result set.Multi[string]
+		expect map[string]int
+	}{
+		{"Union", sets.UnionMulti(a, b), map[string]int{"x": 3, "y": 2, "z": 1}},
+		{"Intersection", sets.IntersectionMulti(a, b), map[string]int{"x": 1, "y": 1}},
+		{"Sum", sets.SumMulti(a, b), map[string]int{"x": 4, "y": 3, "z": 1}},
+		{"Diff", sets.DiffMulti(a, b), map[string]int{"x": 2}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if !maps.Equal(testCase.result, testCase.expect) {
+				t.Logf("expected: %v, got: %v", testCase.expect, testCase.result)
+				t.Fail()
+			}
+		})
+	}
+}
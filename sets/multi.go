@@ -0,0 +1,53 @@
+package sets
+
+import "github.com/leshless/golibrary/set"
+
+// UnionMulti takes maximum count of every item
+func UnionMulti[K comparable](a, b set.Multi[K]) set.Multi[K] {
+	result := make(set.Multi[K], max(len(a), len(b)))
+	for item, count := range a {
+		result[item] = count
+	}
+	for item, count := range b {
+		result[item] = max(result[item], count)
+	}
+
+	return result
+}
+
+// IntersectionMulti takes minimum count of every item
+func IntersectionMulti[K comparable](a, b set.Multi[K]) set.Multi[K] {
+	result := set.NewMulti[K]()
+	for item, count := range a {
+		if other, ok := b[item]; ok {
+			result[item] = min(count, other)
+		}
+	}
+
+	return result
+}
+
+// SumMulti adds up counts of every item
+func SumMulti[K comparable](a, b set.Multi[K]) set.Multi[K] {
+	result := make(set.Multi[K], max(len(a), len(b)))
+	for item, count := range a {
+		result.Add(item, count)
+	}
+	for item, count := range b {
+		result.Add(item, count)
+	}
+
+	return result
+}
+
+// DiffMulti subtracts counts of b from a, items with non-positive counts are dropped
+func DiffMulti[K comparable](a, b set.Multi[K]) set.Multi[K] {
+	result := set.NewMulti[K]()
+	for item, count := range a {
+		if count > b[item] {
+			result[item] = count - b[item]
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,54 @@
+package sets
+
+import "github.com/leshless/golibrary/set"
+
+// UnionOrdered keeps items of a in their order followed by new items of b
+func UnionOrdered[K comparable](a, b *set.Ordered[K]) *set.Ordered[K] {
+	result := set.NewOrdered[K]()
+	for item := range a.All() {
+		result.Add(item)
+	}
+	for item := range b.All() {
+		result.Add(item)
+	}
+
+	return result
+}
+
+func IntersectionOrdered[K comparable](a, b *set.Ordered[K]) *set.Ordered[K] {
+	result := set.NewOrdered[K]()
+	for item := range a.All() {
+		if b.Contains(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+func DiffOrdered[K comparable](a, b *set.Ordered[K]) *set.Ordered[K] {
+	result := set.NewOrdered[K]()
+	for item := range a.All() {
+		if !b.Contains(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+func SymDiffOrdered[K comparable](a, b *set.Ordered[K]) *set.Ordered[K] {
+	result := set.NewOrdered[K]()
+	for item := range a.All() {
+		if !b.Contains(item) {
+			result.Add(item)
+		}
+	}
+	for item := range b.All() {
+		if !a.Contains(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,39 @@
+package xslices
+
+import "runtime"
+
+type ErrorPolicy int
+
+const (
+	// FailFast cancels remaining work on the first error and returns only it
+	FailFast ErrorPolicy = iota
+	// CollectAll processes every element and returns all errors joined
+	CollectAll
+)
+
+type parallelConfig struct {
+	concurrency int
+	errorPolicy ErrorPolicy
+}
+
+func parallelDefaultConfig() parallelConfig {
+	return parallelConfig{
+		concurrency: runtime.GOMAXPROCS(0),
+		errorPolicy: FailFast,
+	}
+}
+
+type ParallelOption func(config *parallelConfig)
+
+// WithConcurrency limits number of simultaneously running callbacks, values less than one are treated as one
+func WithConcurrency(concurrency int) ParallelOption {
+	return func(config *parallelConfig) {
+		config.concurrency = concurrency
+	}
+}
+
+func WithErrorPolicy(policy ErrorPolicy) ParallelOption {
+	return func(config *parallelConfig) {
+		config.errorPolicy = policy
+	}
+}
@@ -0,0 +1,97 @@
+package xslices
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// IndexError wraps error returned by callback for the element with the given index
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// PanicError is returned instead of panic raised by callback, Stack holds the stack of panicked goroutine
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns panic value if it is an error
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// safeCall calls f turning its panic into PanicError
+func safeCall[A any, B any](f func(a A) (B, error), a A) (b B, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	return f(a)
+}
+
+// MapErr maps every element, even if some of the callbacks fail.
+// Errors are wrapped into IndexError and joined in order of elements, nil slice is returned in case of any error
+func MapErr[A any, B any](as []A, mapping func(a A) (B, error)) ([]B, error) {
+	bs := make([]B, 0, len(as))
+	errs := make([]error, 0)
+	for i, a := range as {
+		b, err := safeCall(mapping, a)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+
+		bs = append(bs, b)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return bs, nil
+}
+
+// FilterErr checks every element, even if some of the callbacks fail.
+// Errors are wrapped into IndexError and joined in order of elements, nil slice is returned in case of any error
+func FilterErr[A any](as []A, predicate func(a A) (bool, error)) ([]A, error) {
+	filtered := make([]A, 0, len(as))
+	errs := make([]error, 0)
+	for i, a := range as {
+		ok, err := safeCall(predicate, a)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+
+		if ok {
+			filtered = append(filtered, a)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,76 @@
+package xslices_test
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/leshless/golibrary/xslices"
+)
+
+func TestMapErr(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		result, err := xslices.MapErr([]string{"1", "2"}, strconv.Atoi)
+		if err != nil || slices.Compare(result, []int{1, 2}) != 0 {
+			t.Logf("unexpected result: %v, %v", result, err)
+			t.Fail()
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		result, err := xslices.MapErr([]string{"1", "a", "2", "b"}, strconv.Atoi)
+		if result != nil {
+			t.Logf("expected nil result, got: %v", result)
+			t.Fail()
+		}
+
+		indexes := make([]int, 0)
+		for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+			var indexErr *xslices.IndexError
+			if errors.As(err, &indexErr) {
+				indexes = append(indexes, indexErr.Index)
+			}
+		}
+
+		if slices.Compare(indexes, []int{1, 3}) != 0 {
+			t.Logf("expected errors at: [1 3], got: %v", indexes)
+			t.Fail()
+		}
+
+		if !errors.Is(err, strconv.ErrSyntax) {
+			t.Logf("expected error to wrap ErrSyntax: %v", err)
+			t.Fail()
+		}
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		_, err := xslices.MapErr([]int{1, 0}, func(n int) (int, error) { return 1 / n, nil })
+
+		var panicErr *xslices.PanicError
+		var indexErr *xslices.IndexError
+		if !errors.As(err, &panicErr) || !errors.As(err, &indexErr) || indexErr.Index != 1 {
+			t.Logf("expected panic error at index 1, got: %v", err)
+			t.Fail()
+		}
+	})
+}
+
+func TestFilterErr(t *testing.T) {
+	isEven := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+
+	result, err := xslices.FilterErr([]string{"1", "2", "4"}, isEven)
+	if err != nil || slices.Compare(result, []string{"2", "4"}) != 0 {
+		t.Logf("unexpected result: %v, %v", result, err)
+		t.Fail()
+	}
+
+	var indexErr *xslices.IndexError
+	if _, err := xslices.FilterErr([]string{"1", "x"}, isEven); !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Logf("expected error at index 1, got: %v", err)
+		t.Fail()
+	}
+}
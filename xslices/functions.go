@@ -1,5 +1,10 @@
 package xslices
 
+import (
+	"github.com/leshless/golibrary/optional"
+	"github.com/leshless/golibrary/pair"
+)
+
 func Map[A any, B any](as []A, mapping func(a A) B) []B {
 	bs := make([]B, 0, len(as))
 	for _, a := range as {
@@ -19,3 +24,215 @@ func Filter[A any](as []A, predicate func(a A) bool) []A {
 
 	return filtered
 }
+
+func FlatMap[A any, B any](as []A, mapping func(a A) []B) []B {
+	bs := make([]B, 0, len(as))
+	for _, a := range as {
+		bs = append(bs, mapping(a)...)
+	}
+
+	return bs
+}
+
+func Flatten[A any](ass [][]A) []A {
+	size := 0
+	for _, as := range ass {
+		size += len(as)
+	}
+
+	flattened := make([]A, 0, size)
+	for _, as := range ass {
+		flattened = append(flattened, as...)
+	}
+
+	return flattened
+}
+
+func Fold[A any, B any](as []A, initial B, folder func(acc B, a A) B) B {
+	acc := initial
+	for _, a := range as {
+		acc = folder(acc, a)
+	}
+
+	return acc
+}
+
+// Reduce folds slice starting from its first element, None is returned for empty slice
+func Reduce[A any](as []A, reducer func(acc A, a A) A) optional.T[A] {
+	if len(as) == 0 {
+		return optional.None[A]()
+	}
+
+	return optional.Some(Fold(as[1:], as[0], reducer))
+}
+
+func GroupBy[A any, K comparable](as []A, key func(a A) K) map[K][]A {
+	groups := make(map[K][]A)
+	for _, a := range as {
+		k := key(a)
+		groups[k] = append(groups[k], a)
+	}
+
+	return groups
+}
+
+// Partition splits slice into elements which satisfy predicate and the rest, keeping their order
+func Partition[A any](as []A, predicate func(a A) bool) ([]A, []A) {
+	matched := make([]A, 0, len(as))
+	rest := make([]A, 0)
+	for _, a := range as {
+		if predicate(a) {
+			matched = append(matched, a)
+		} else {
+			rest = append(rest, a)
+		}
+	}
+
+	return matched, rest
+}
+
+// Chunk splits slice into consecutive subslices of the given size, the last one may be shorter.
+// Chunks share memory with the original slice, but their capacity is clipped, so appending to them is safe
+func Chunk[A any](as []A, size int) [][]A {
+	if size <= 0 {
+		panic("xslices: chunk size must be positive")
+	}
+
+	chunks := make([][]A, 0, (len(as)+size-1)/size)
+	for i := 0; i < len(as); i += size {
+		end := min(i+size, len(as))
+		chunks = append(chunks, as[i:end:end])
+	}
+
+	return chunks
+}
+
+// Window returns all subslices of the given size in order, sharing memory with the original slice
+func Window[A any](as []A, size int) [][]A {
+	if size <= 0 {
+		panic("xslices: window size must be positive")
+	}
+
+	if len(as) < size {
+		return [][]A{}
+	}
+
+	windows := make([][]A, 0, len(as)-size+1)
+	for i := 0; i+size <= len(as); i++ {
+		windows = append(windows, as[i:i+size:i+size])
+	}
+
+	return windows
+}
+
+// Zip pairs elements with the same index, the longer slice is truncated
+func Zip[A any, B any](as []A, bs []B) []pair.T[A, B] {
+	n := min(len(as), len(bs))
+
+	pairs := make([]pair.T[A, B], 0, n)
+	for i := range n {
+		pairs = append(pairs, pair.New(as[i], bs[i]))
+	}
+
+	return pairs
+}
+
+func Unzip[A any, B any](pairs []pair.T[A, B]) ([]A, []B) {
+	as := make([]A, 0, len(pairs))
+	bs := make([]B, 0, len(pairs))
+	for _, p := range pairs {
+		as = append(as, p.First)
+		bs = append(bs, p.Second)
+	}
+
+	return as, bs
+}
+
+// Distinct keeps the first occurrence of every element
+func Distinct[A comparable](as []A) []A {
+	return DistinctBy(as, func(a A) A { return a })
+}
+
+// DistinctBy keeps the first element for every key
+func DistinctBy[A any, K comparable](as []A, key func(a A) K) []A {
+	seen := make(map[K]struct{}, len(as))
+	distinct := make([]A, 0, len(as))
+	for _, a := range as {
+		k := key(a)
+		if _, exists := seen[k]; exists {
+			continue
+		}
+
+		seen[k] = struct{}{}
+		distinct = append(distinct, a)
+	}
+
+	return distinct
+}
+
+func Count[A any](as []A, predicate func(a A) bool) int {
+	count := 0
+	for _, a := range as {
+		if predicate(a) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func Any[A any](as []A, predicate func(a A) bool) bool {
+	for _, a := range as {
+		if predicate(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// All returns true for empty slice
+func All[A any](as []A, predicate func(a A) bool) bool {
+	for _, a := range as {
+		if !predicate(a) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func None[A any](as []A, predicate func(a A) bool) bool {
+	return !Any(as, predicate)
+}
+
+func Find[A any](as []A, predicate func(a A) bool) optional.T[A] {
+	for _, a := range as {
+		if predicate(a) {
+			return optional.Some(a)
+		}
+	}
+
+	return optional.None[A]()
+}
+
+// IndexBy maps elements by key, later elements override earlier ones with the same key
+func IndexBy[A any, K comparable](as []A, key func(a A) K) map[K]A {
+	index := make(map[K]A, len(as))
+	for _, a := range as {
+		index[key(a)] = a
+	}
+
+	return index
+}
+
+// Associate builds map of key-value pairs produced from elements, later pairs override earlier ones with the same key
+func Associate[A any, K comparable, V any](as []A, association func(a A) (K, V)) map[K]V {
+	m := make(map[K]V, len(as))
+	for _, a := range as {
+		k, v := association(a)
+		m[k] = v
+	}
+
+	return m
+}
@@ -0,0 +1,157 @@
+package xslices_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/leshless/golibrary/optional"
+	"github.com/leshless/golibrary/pair"
+	"github.com/leshless/golibrary/xslices"
+)
+
+func TestChunk(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []int
+		size   int
+		result [][]int
+	}{
+		{"Empty", []int{}, 2, [][]int{}},
+		{"Even", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"Remainder", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"Larger", []int{1, 2}, 5, [][]int{{1, 2}}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := xslices.Chunk(testCase.input, testCase.size)
+			if !slices.EqualFunc(result, testCase.result, slices.Equal) {
+				t.Logf("expected: %v, got: %v", testCase.result, result)
+				t.Fail()
+			}
+		})
+	}
+
+	t.Run("ClippedCapacity", func(t *testing.T) {
+		input := []int{1, 2, 3, 4}
+		chunks := xslices.Chunk(input, 2)
+		_ = append(chunks[0], 100)
+
+		if input[2] != 3 {
+			t.Logf("appending to chunk modified original slice: %v", input)
+			t.Fail()
+		}
+	})
+}
+
+func TestWindow(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []int
+		size   int
+		result [][]int
+	}{
+		{"Short", []int{1}, 2, [][]int{}},
+		{"Exact", []int{1, 2}, 2, [][]int{{1, 2}}},
+		{"Sliding", []int{1, 2, 3, 4}, 3, [][]int{{1, 2, 3}, {2, 3, 4}}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := xslices.Window(testCase.input, testCase.size)
+			if !slices.EqualFunc(result, testCase.result, slices.Equal) {
+				t.Logf("expected: %v, got: %v", testCase.result, result)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestGrouping(t *testing.T) {
+	words := []string{"apple", "avocado", "banana", "blueberry", "cherry", "apple"}
+	first := func(s string) byte { return s[0] }
+
+	groups := xslices.GroupBy(words, first)
+	if len(groups) != 3 || slices.Compare(groups['b'], []string{"banana", "blueberry"}) != 0 {
+		t.Logf("unexpected groups: %v", groups)
+		t.Fail()
+	}
+
+	if distinct := xslices.Distinct(words); len(distinct) != 5 {
+		t.Logf("unexpected distinct: %v", distinct)
+		t.Fail()
+	}
+
+	if distinct := xslices.DistinctBy(words, first); slices.Compare(distinct, []string{"apple", "banana", "cherry"}) != 0 {
+		t.Logf("unexpected distinct by: %v", distinct)
+		t.Fail()
+	}
+
+	long, short := xslices.Partition(words, func(s string) bool { return len(s) > 6 })
+	if slices.Compare(long, []string{"avocado", "blueberry"}) != 0 || len(short) != 4 {
+		t.Logf("unexpected partition: %v, %v", long, short)
+		t.Fail()
+	}
+
+	lengths := xslices.Associate(words, func(s string) (string, int) { return s, len(s) })
+	if lengths["banana"] != 6 || len(lengths) != 5 {
+		t.Logf("unexpected association: %v", lengths)
+		t.Fail()
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(acc, n int) int { return acc + n }
+
+	if result := xslices.Reduce([]int{1, 2, 3}, sum); result != optional.Some(6) {
+		t.Logf("expected: 6, got: %v", result)
+		t.Fail()
+	}
+
+	if result := xslices.Reduce([]int{}, sum); result != optional.None[int]() {
+		t.Logf("expected: None, got: %v", result)
+		t.Fail()
+	}
+
+	joined := xslices.Fold([]string{"a", "b"}, "", func(acc string, s string) string { return acc + s })
+	if joined != "ab" {
+		t.Logf("expected: ab, got: %s", joined)
+		t.Fail()
+	}
+}
+
+func TestZip(t *testing.T) {
+	pairs := xslices.Zip([]int{1, 2, 3}, []string{"a", "b"})
+	if slices.Compare(xslices.Map(pairs, func(p pair.T[int, string]) int { return p.First }), []int{1, 2}) != 0 {
+		t.Logf("unexpected pairs: %v", pairs)
+		t.Fail()
+	}
+
+	as, bs := xslices.Unzip(pairs)
+	if slices.Compare(as, []int{1, 2}) != 0 || slices.Compare(bs, []string{"a", "b"}) != 0 {
+		t.Logf("unexpected unzip: %v, %v", as, bs)
+		t.Fail()
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	words := []string{"go", "rust", "zig"}
+	hasR := func(s string) bool { return strings.Contains(s, "r") }
+
+	if !xslices.Any(words, hasR) || xslices.All(words, hasR) || xslices.None(words, hasR) || xslices.Count(words, hasR) != 1 {
+		t.Logf("unexpected predicate results")
+		t.Fail()
+	}
+
+	if result := xslices.Find(words, hasR); result != optional.Some("rust") {
+		t.Logf("expected: rust, got: %v", result)
+		t.Fail()
+	}
+
+	flattened := xslices.FlatMap(words, func(s string) []byte { return []byte(s[:1]) })
+	if string(flattened) != "grz" {
+		t.Logf("expected: grz, got: %s", flattened)
+		t.Fail()
+	}
+}
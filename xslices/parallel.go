@@ -0,0 +1,78 @@
+package xslices
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelMap maps elements concurrently, preserving their order in the result.
+// Callback receives context which is cancelled when the parent one is, or on the first error with FailFast policy.
+// Elements which were not started before cancellation are skipped and the context cause is returned.
+// Errors are wrapped into IndexError, panics are recovered into PanicError. Nil slice is returned in case of any error
+func ParallelMap[A any, B any](ctx context.Context, as []A, mapping func(ctx context.Context, a A) (B, error), options ...ParallelOption) ([]B, error) {
+	config := parallelDefaultConfig()
+	for _, option := range options {
+		option(&config)
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	call := func(a A) (B, error) {
+		return mapping(ctx, a)
+	}
+
+	bs := make([]B, len(as))
+	errs := make([]error, len(as))
+
+	var next atomic.Int64
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+
+	for range min(max(config.concurrency, 1), len(as)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for ctx.Err() == nil {
+				i := int(next.Add(1) - 1)
+				if i >= len(as) {
+					return
+				}
+
+				b, err := safeCall(call, as[i])
+				if err != nil {
+					errs[i] = &IndexError{Index: i, Err: err}
+					failed.Store(true)
+
+					if config.errorPolicy == FailFast {
+						cancel(errs[i])
+					}
+
+					continue
+				}
+
+				bs[i] = b
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// every started element is finished at this point, so unstarted ones mean cancellation
+	unfinished := int(next.Load()) < len(as)
+
+	switch {
+	case config.errorPolicy == FailFast && (failed.Load() || unfinished):
+		return nil, context.Cause(ctx)
+	case unfinished:
+		return nil, errors.Join(context.Cause(ctx), errors.Join(errs...))
+	case failed.Load():
+		return nil, errors.Join(errs...)
+	}
+
+	return bs, nil
+}
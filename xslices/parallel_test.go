@@ -0,0 +1,140 @@
+package xslices_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/leshless/golibrary/xslices"
+)
+
+var errOdd = errors.New("odd")
+
+func TestParallelMap(t *testing.T) {
+	input := make([]int, 100)
+	for i := range input {
+		input[i] = i
+	}
+
+	t.Run("Order", func(t *testing.T) {
+		result, err := xslices.ParallelMap(context.Background(), input, func(ctx context.Context, n int) (int, error) {
+			time.Sleep(time.Duration(100-n) * time.Microsecond)
+			return n * 2, nil
+		}, xslices.WithConcurrency(8))
+
+		expected := xslices.Map(input, func(n int) int { return n * 2 })
+		if err != nil || slices.Compare(result, expected) != 0 {
+			t.Logf("unexpected result: %v, %v", result, err)
+			t.Fail()
+		}
+	})
+
+	t.Run("Concurrency", func(t *testing.T) {
+		var active, peak atomic.Int64
+
+		_, err := xslices.ParallelMap(context.Background(), input, func(ctx context.Context, n int) (int, error) {
+			current := active.Add(1)
+			defer active.Add(-1)
+
+			for {
+				old := peak.Load()
+				if current <= old || peak.CompareAndSwap(old, current) {
+					break
+				}
+			}
+
+			time.Sleep(100 * time.Microsecond)
+			return n, nil
+		}, xslices.WithConcurrency(3))
+
+		if err != nil || peak.Load() > 3 {
+			t.Logf("expected at most 3 concurrent calls, got: %d, %v", peak.Load(), err)
+			t.Fail()
+		}
+	})
+
+	t.Run("FailFast", func(t *testing.T) {
+		var calls atomic.Int64
+
+		result, err := xslices.ParallelMap(context.Background(), input, func(ctx context.Context, n int) (int, error) {
+			calls.Add(1)
+			if n == 0 {
+				return 0, errOdd
+			}
+
+			<-ctx.Done()
+			return n, ctx.Err()
+		}, xslices.WithConcurrency(2))
+
+		var indexErr *xslices.IndexError
+		if result != nil || !errors.As(err, &indexErr) || indexErr.Index != 0 || !errors.Is(err, errOdd) {
+			t.Logf("expected first error at index 0, got: %v", err)
+			t.Fail()
+		}
+
+		if calls.Load() >= int64(len(input)) {
+			t.Logf("expected remaining elements to be skipped, got %d calls", calls.Load())
+			t.Fail()
+		}
+	})
+
+	t.Run("CollectAll", func(t *testing.T) {
+		_, err := xslices.ParallelMap(context.Background(), input, func(ctx context.Context, n int) (int, error) {
+			if n%2 == 1 {
+				return 0, errOdd
+			}
+
+			return n, nil
+		}, xslices.WithErrorPolicy(xslices.CollectAll))
+
+		errs := err.(interface{ Unwrap() []error }).Unwrap()
+		if len(errs) != len(input)/2 {
+			t.Logf("expected %d errors, got: %d", len(input)/2, len(errs))
+			t.Fail()
+		}
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		_, err := xslices.ParallelMap(context.Background(), input, func(ctx context.Context, n int) (int, error) {
+			if n == 42 {
+				panic("boom")
+			}
+
+			return n, nil
+		})
+
+		var panicErr *xslices.PanicError
+		if !errors.As(err, &panicErr) || panicErr.Value != "boom" {
+			t.Logf("expected recovered panic, got: %v", err)
+			t.Fail()
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := xslices.ParallelMap(ctx, input, func(ctx context.Context, n int) (int, error) {
+			return n, nil
+		}, xslices.WithErrorPolicy(xslices.CollectAll))
+
+		if !errors.Is(err, context.Canceled) {
+			t.Logf("expected context cancellation, got: %v", err)
+			t.Fail()
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		result, err := xslices.ParallelMap(context.Background(), []int{}, func(ctx context.Context, n int) (int, error) {
+			return n, nil
+		})
+
+		if err != nil || len(result) != 0 {
+			t.Logf("unexpected result: %v, %v", result, err)
+			t.Fail()
+		}
+	})
+}